@@ -74,16 +74,129 @@ func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{0}
 }
 
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_UNKNOWN   OrderStatus = 0
+	OrderStatus_ORDER_ACTIVE    OrderStatus = 1
+	OrderStatus_ORDER_COMPLETED OrderStatus = 2
+	OrderStatus_ORDER_CANCELLED OrderStatus = 3
+	OrderStatus_ORDER_EXPIRED   OrderStatus = 4
+	OrderStatus_ORDER_FAILED    OrderStatus = 5
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_UNKNOWN",
+		1: "ORDER_ACTIVE",
+		2: "ORDER_COMPLETED",
+		3: "ORDER_CANCELLED",
+		4: "ORDER_EXPIRED",
+		5: "ORDER_FAILED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_UNKNOWN":   0,
+		"ORDER_ACTIVE":    1,
+		"ORDER_COMPLETED": 2,
+		"ORDER_CANCELLED": 3,
+		"ORDER_EXPIRED":   4,
+		"ORDER_FAILED":    5,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_quoteswap_quoteswap_proto_enumTypes[1].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_quoteswap_quoteswap_proto_enumTypes[1]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{1}
+}
+
+type TriggerKind int32
+
+const (
+	TriggerKind_TRIGGER_UNKNOWN TriggerKind = 0
+	TriggerKind_STOP_LOSS       TriggerKind = 1
+	TriggerKind_TAKE_PROFIT     TriggerKind = 2
+)
+
+// Enum value maps for TriggerKind.
+var (
+	TriggerKind_name = map[int32]string{
+		0: "TRIGGER_UNKNOWN",
+		1: "STOP_LOSS",
+		2: "TAKE_PROFIT",
+	}
+	TriggerKind_value = map[string]int32{
+		"TRIGGER_UNKNOWN": 0,
+		"STOP_LOSS":       1,
+		"TAKE_PROFIT":     2,
+	}
+)
+
+func (x TriggerKind) Enum() *TriggerKind {
+	p := new(TriggerKind)
+	*p = x
+	return p
+}
+
+func (x TriggerKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TriggerKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_quoteswap_quoteswap_proto_enumTypes[2].Descriptor()
+}
+
+func (TriggerKind) Type() protoreflect.EnumType {
+	return &file_quoteswap_quoteswap_proto_enumTypes[2]
+}
+
+func (x TriggerKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TriggerKind.Descriptor instead.
+func (TriggerKind) EnumDescriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{2}
+}
+
 type GetQuoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TokenIn       string                 `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
-	TokenOut      string                 `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
-	Amount        uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Dex           string                 `protobuf:"bytes,4,opt,name=dex,proto3" json:"dex,omitempty"`
-	SlippageBps   uint32                 `protobuf:"varint,5,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
-	Chain         string                 `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TokenIn  string                 `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut string                 `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	// Deprecated: Marked as deprecated in quoteswap/quoteswap.proto.
+	Amount             uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Dex                string `protobuf:"bytes,4,opt,name=dex,proto3" json:"dex,omitempty"`
+	SlippageBps        uint32 `protobuf:"varint,5,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	Chain              string `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
+	AmountDecimal      string `protobuf:"bytes,7,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	AmountIn           string `protobuf:"bytes,8,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	MaxStalenessBlocks uint32 `protobuf:"varint,9,opt,name=max_staleness_blocks,json=maxStalenessBlocks,proto3" json:"max_staleness_blocks,omitempty"`
+	BlockNumber        uint64 `protobuf:"varint,10,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash          string `protobuf:"bytes,11,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetQuoteRequest) Reset() {
@@ -130,6 +243,7 @@ func (x *GetQuoteRequest) GetTokenOut() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in quoteswap/quoteswap.proto.
 func (x *GetQuoteRequest) GetAmount() uint64 {
 	if x != nil {
 		return x.Amount
@@ -158,17 +272,65 @@ func (x *GetQuoteRequest) GetChain() string {
 	return ""
 }
 
+func (x *GetQuoteRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *GetQuoteRequest) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *GetQuoteRequest) GetMaxStalenessBlocks() uint32 {
+	if x != nil {
+		return x.MaxStalenessBlocks
+	}
+	return 0
+}
+
+func (x *GetQuoteRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetQuoteRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
 type GetQuoteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InputToken    string                 `protobuf:"bytes,1,opt,name=input_token,json=inputToken,proto3" json:"input_token,omitempty"`
-	InAmount      string                 `protobuf:"bytes,2,opt,name=in_amount,json=inAmount,proto3" json:"in_amount,omitempty"`
-	OutputToken   string                 `protobuf:"bytes,3,opt,name=output_token,json=outputToken,proto3" json:"output_token,omitempty"`
-	OutAmount     string                 `protobuf:"bytes,4,opt,name=out_amount,json=outAmount,proto3" json:"out_amount,omitempty"`
-	SlippageBps   int32                  `protobuf:"varint,5,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
-	Dex           string                 `protobuf:"bytes,6,opt,name=dex,proto3" json:"dex,omitempty"`
-	Chain         string                 `protobuf:"bytes,7,opt,name=chain,proto3" json:"chain,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	InputToken              string                 `protobuf:"bytes,1,opt,name=input_token,json=inputToken,proto3" json:"input_token,omitempty"`
+	InAmount                string                 `protobuf:"bytes,2,opt,name=in_amount,json=inAmount,proto3" json:"in_amount,omitempty"`
+	OutputToken             string                 `protobuf:"bytes,3,opt,name=output_token,json=outputToken,proto3" json:"output_token,omitempty"`
+	OutAmount               string                 `protobuf:"bytes,4,opt,name=out_amount,json=outAmount,proto3" json:"out_amount,omitempty"`
+	SlippageBps             int32                  `protobuf:"varint,5,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	Dex                     string                 `protobuf:"bytes,6,opt,name=dex,proto3" json:"dex,omitempty"`
+	Chain                   string                 `protobuf:"bytes,7,opt,name=chain,proto3" json:"chain,omitempty"`
+	InAmountDecimal         string                 `protobuf:"bytes,8,opt,name=in_amount_decimal,json=inAmountDecimal,proto3" json:"in_amount_decimal,omitempty"`
+	OutAmountDecimal        string                 `protobuf:"bytes,9,opt,name=out_amount_decimal,json=outAmountDecimal,proto3" json:"out_amount_decimal,omitempty"`
+	CacheHit                bool                   `protobuf:"varint,10,opt,name=cache_hit,json=cacheHit,proto3" json:"cache_hit,omitempty"`
+	BlockNumber             uint64                 `protobuf:"varint,11,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,12,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockTimestamp          uint64                 `protobuf:"varint,13,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	SqrtPriceX96After       string                 `protobuf:"bytes,14,opt,name=sqrt_price_x96_after,json=sqrtPriceX96After,proto3" json:"sqrt_price_x96_after,omitempty"`
+	InitializedTicksCrossed uint32                 `protobuf:"varint,15,opt,name=initialized_ticks_crossed,json=initializedTicksCrossed,proto3" json:"initialized_ticks_crossed,omitempty"`
+	Pool                    *Pool                  `protobuf:"bytes,16,opt,name=pool,proto3" json:"pool,omitempty"`
+	MidPrice                string                 `protobuf:"bytes,17,opt,name=mid_price,json=midPrice,proto3" json:"mid_price,omitempty"`
+	ExecutionPrice          string                 `protobuf:"bytes,18,opt,name=execution_price,json=executionPrice,proto3" json:"execution_price,omitempty"`
+	PriceImpactBps          string                 `protobuf:"bytes,19,opt,name=price_impact_bps,json=priceImpactBps,proto3" json:"price_impact_bps,omitempty"`
+	Splits                  []*SplitLeg            `protobuf:"bytes,20,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *GetQuoteResponse) Reset() {
@@ -250,27 +412,120 @@ func (x *GetQuoteResponse) GetChain() string {
 	return ""
 }
 
-type ExecuteTxRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	QuotingResponse *GetQuoteResponse      `protobuf:"bytes,1,opt,name=quoting_response,json=quotingResponse,proto3" json:"quoting_response,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetQuoteResponse) GetInAmountDecimal() string {
+	if x != nil {
+		return x.InAmountDecimal
+	}
+	return ""
 }
 
-func (x *ExecuteTxRequest) Reset() {
-	*x = ExecuteTxRequest{}
+func (x *GetQuoteResponse) GetOutAmountDecimal() string {
+	if x != nil {
+		return x.OutAmountDecimal
+	}
+	return ""
+}
+
+func (x *GetQuoteResponse) GetCacheHit() bool {
+	if x != nil {
+		return x.CacheHit
+	}
+	return false
+}
+
+func (x *GetQuoteResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetQuoteResponse) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetSqrtPriceX96After() string {
+	if x != nil {
+		return x.SqrtPriceX96After
+	}
+	return ""
+}
+
+func (x *GetQuoteResponse) GetInitializedTicksCrossed() uint32 {
+	if x != nil {
+		return x.InitializedTicksCrossed
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetPool() *Pool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *GetQuoteResponse) GetMidPrice() string {
+	if x != nil {
+		return x.MidPrice
+	}
+	return ""
+}
+
+func (x *GetQuoteResponse) GetExecutionPrice() string {
+	if x != nil {
+		return x.ExecutionPrice
+	}
+	return ""
+}
+
+func (x *GetQuoteResponse) GetPriceImpactBps() string {
+	if x != nil {
+		return x.PriceImpactBps
+	}
+	return ""
+}
+
+func (x *GetQuoteResponse) GetSplits() []*SplitLeg {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type SplitLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pool          *Pool                  `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	InAmount      string                 `protobuf:"bytes,2,opt,name=in_amount,json=inAmount,proto3" json:"in_amount,omitempty"`
+	OutAmount     string                 `protobuf:"bytes,3,opt,name=out_amount,json=outAmount,proto3" json:"out_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitLeg) Reset() {
+	*x = SplitLeg{}
 	mi := &file_quoteswap_quoteswap_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteTxRequest) String() string {
+func (x *SplitLeg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteTxRequest) ProtoMessage() {}
+func (*SplitLeg) ProtoMessage() {}
 
-func (x *ExecuteTxRequest) ProtoReflect() protoreflect.Message {
+func (x *SplitLeg) ProtoReflect() protoreflect.Message {
 	mi := &file_quoteswap_quoteswap_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -282,43 +537,53 @@ func (x *ExecuteTxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteTxRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteTxRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SplitLeg.ProtoReflect.Descriptor instead.
+func (*SplitLeg) Descriptor() ([]byte, []int) {
 	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ExecuteTxRequest) GetQuotingResponse() *GetQuoteResponse {
+func (x *SplitLeg) GetPool() *Pool {
 	if x != nil {
-		return x.QuotingResponse
+		return x.Pool
 	}
 	return nil
 }
 
-type ExecuteTxResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TransactionHash string            `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
-	Status          TransactionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=quoteswap.TransactionStatus" json:"status,omitempty"`
-	SellTokenQty    float64           `protobuf:"fixed64,3,opt,name=sell_token_qty,json=sellTokenQty,proto3" json:"sell_token_qty,omitempty"`
-	Error           *Error            `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	ExecutedPrice   float64           `protobuf:"fixed64,5,opt,name=executed_price,json=executedPrice,proto3" json:"executed_price,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *SplitLeg) GetInAmount() string {
+	if x != nil {
+		return x.InAmount
+	}
+	return ""
 }
 
-func (x *ExecuteTxResponse) Reset() {
-	*x = ExecuteTxResponse{}
+func (x *SplitLeg) GetOutAmount() string {
+	if x != nil {
+		return x.OutAmount
+	}
+	return ""
+}
+
+type BatchGetQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotes        []*GetQuoteRequest     `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetQuoteRequest) Reset() {
+	*x = BatchGetQuoteRequest{}
 	mi := &file_quoteswap_quoteswap_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteTxResponse) String() string {
+func (x *BatchGetQuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteTxResponse) ProtoMessage() {}
+func (*BatchGetQuoteRequest) ProtoMessage() {}
 
-func (x *ExecuteTxResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchGetQuoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_quoteswap_quoteswap_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -330,69 +595,85 @@ func (x *ExecuteTxResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteTxResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteTxResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetQuoteRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetQuoteRequest) Descriptor() ([]byte, []int) {
 	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ExecuteTxResponse) GetTransactionHash() string {
+func (x *BatchGetQuoteRequest) GetQuotes() []*GetQuoteRequest {
 	if x != nil {
-		return x.TransactionHash
+		return x.Quotes
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecuteTxResponse) GetStatus() TransactionStatus {
-	if x != nil {
-		return x.Status
-	}
-	return TransactionStatus_UNKNOWN
+type BatchGetQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchQuoteResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecuteTxResponse) GetSellTokenQty() float64 {
-	if x != nil {
-		return x.SellTokenQty
-	}
-	return 0
+func (x *BatchGetQuoteResponse) Reset() {
+	*x = BatchGetQuoteResponse{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteTxResponse) GetError() *Error {
+func (x *BatchGetQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetQuoteResponse) ProtoMessage() {}
+
+func (x *BatchGetQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[4]
 	if x != nil {
-		return x.Error
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ExecuteTxResponse) GetExecutedPrice() float64 {
+// Deprecated: Use BatchGetQuoteResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetQuoteResponse) GetResults() []*BatchQuoteResult {
 	if x != nil {
-		return x.ExecutedPrice
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-type Error struct {
+type BatchQuoteResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Quote         *GetQuoteResponse      `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Error) Reset() {
-	*x = Error{}
-	mi := &file_quoteswap_quoteswap_proto_msgTypes[4]
+func (x *BatchQuoteResult) Reset() {
+	*x = BatchQuoteResult{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Error) String() string {
+func (x *BatchQuoteResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Error) ProtoMessage() {}
+func (*BatchQuoteResult) ProtoMessage() {}
 
-func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_quoteswap_quoteswap_proto_msgTypes[4]
+func (x *BatchQuoteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,37 +684,3384 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Error.ProtoReflect.Descriptor instead.
-func (*Error) Descriptor() ([]byte, []int) {
-	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BatchQuoteResult.ProtoReflect.Descriptor instead.
+func (*BatchQuoteResult) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Error) GetCode() int32 {
+func (x *BatchQuoteResult) GetQuote() *GetQuoteResponse {
 	if x != nil {
-		return x.Code
+		return x.Quote
 	}
-	return 0
+	return nil
 }
 
-func (x *Error) GetMessage() string {
+func (x *BatchQuoteResult) GetError() *Error {
 	if x != nil {
-		return x.Message
+		return x.Error
 	}
-	return ""
+	return nil
+}
+
+type GetTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenRequest) Reset() {
+	*x = GetTokenRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenRequest) ProtoMessage() {}
+
+func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTokenRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *GetTokenRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type Token struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Chain              string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address            string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol             string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals           uint32                 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	TotalSupply        string                 `protobuf:"bytes,6,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	TotalSupplyDecimal string                 `protobuf:"bytes,7,opt,name=total_supply_decimal,json=totalSupplyDecimal,proto3" json:"total_supply_decimal,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Token) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *Token) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Token) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Token) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Token) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Token) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
+func (x *Token) GetTotalSupplyDecimal() string {
+	if x != nil {
+		return x.TotalSupplyDecimal
+	}
+	return ""
+}
+
+type TokenRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenRef) Reset() {
+	*x = TokenRef{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRef) ProtoMessage() {}
+
+func (x *TokenRef) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRef.ProtoReflect.Descriptor instead.
+func (*TokenRef) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TokenRef) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *TokenRef) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GetBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chains        []string               `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	Tokens        []*TokenRef            `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	QuoteTokens   map[string]string      `protobuf:"bytes,3,rep,name=quote_tokens,json=quoteTokens,proto3" json:"quote_tokens,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalancesRequest) Reset() {
+	*x = GetBalancesRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesRequest) ProtoMessage() {}
+
+func (x *GetBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBalancesRequest) GetChains() []string {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+func (x *GetBalancesRequest) GetTokens() []*TokenRef {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *GetBalancesRequest) GetQuoteTokens() map[string]string {
+	if x != nil {
+		return x.QuoteTokens
+	}
+	return nil
+}
+
+type GetBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallets       []*WalletBalances      `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalancesResponse) Reset() {
+	*x = GetBalancesResponse{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesResponse) ProtoMessage() {}
+
+func (x *GetBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBalancesResponse) GetWallets() []*WalletBalances {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+type WalletBalances struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Wallet            string                 `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Balances          []*Balance             `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	TotalValueDecimal map[string]string      `protobuf:"bytes,3,rep,name=total_value_decimal,json=totalValueDecimal,proto3" json:"total_value_decimal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WalletBalances) Reset() {
+	*x = WalletBalances{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletBalances) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletBalances) ProtoMessage() {}
+
+func (x *WalletBalances) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletBalances.ProtoReflect.Descriptor instead.
+func (*WalletBalances) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WalletBalances) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *WalletBalances) GetBalances() []*Balance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *WalletBalances) GetTotalValueDecimal() map[string]string {
+	if x != nil {
+		return x.TotalValueDecimal
+	}
+	return nil
+}
+
+type Balance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,5,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	Value         string                 `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	ValueDecimal  string                 `protobuf:"bytes,7,opt,name=value_decimal,json=valueDecimal,proto3" json:"value_decimal,omitempty"`
+	ValueToken    string                 `protobuf:"bytes,8,opt,name=value_token,json=valueToken,proto3" json:"value_token,omitempty"`
+	Error         *Error                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Balance) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *Balance) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Balance) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Balance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Balance) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *Balance) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Balance) GetValueDecimal() string {
+	if x != nil {
+		return x.ValueDecimal
+	}
+	return ""
+}
+
+func (x *Balance) GetValueToken() string {
+	if x != nil {
+		return x.ValueToken
+	}
+	return ""
+}
+
+func (x *Balance) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ExecuteTxRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	QuotingResponse *GetQuoteResponse      `protobuf:"bytes,1,opt,name=quoting_response,json=quotingResponse,proto3" json:"quoting_response,omitempty"`
+	WaitForReceipt  bool                   `protobuf:"varint,2,opt,name=wait_for_receipt,json=waitForReceipt,proto3" json:"wait_for_receipt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExecuteTxRequest) Reset() {
+	*x = ExecuteTxRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteTxRequest) ProtoMessage() {}
+
+func (x *ExecuteTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteTxRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteTxRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExecuteTxRequest) GetQuotingResponse() *GetQuoteResponse {
+	if x != nil {
+		return x.QuotingResponse
+	}
+	return nil
+}
+
+func (x *ExecuteTxRequest) GetWaitForReceipt() bool {
+	if x != nil {
+		return x.WaitForReceipt
+	}
+	return false
+}
+
+type ExecuteTxResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionHash string                 `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Status          TransactionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=quoteswap.TransactionStatus" json:"status,omitempty"`
+	// Deprecated: Marked as deprecated in quoteswap/quoteswap.proto.
+	SellTokenQty float64 `protobuf:"fixed64,3,opt,name=sell_token_qty,json=sellTokenQty,proto3" json:"sell_token_qty,omitempty"`
+	Error        *Error  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Deprecated: Marked as deprecated in quoteswap/quoteswap.proto.
+	ExecutedPrice        float64 `protobuf:"fixed64,5,opt,name=executed_price,json=executedPrice,proto3" json:"executed_price,omitempty"`
+	SellTokenAmount      string  `protobuf:"bytes,6,opt,name=sell_token_amount,json=sellTokenAmount,proto3" json:"sell_token_amount,omitempty"`
+	ExecutedPriceDecimal string  `protobuf:"bytes,7,opt,name=executed_price_decimal,json=executedPriceDecimal,proto3" json:"executed_price_decimal,omitempty"`
+	Fill                 *Fill   `protobuf:"bytes,8,opt,name=fill,proto3" json:"fill,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ExecuteTxResponse) Reset() {
+	*x = ExecuteTxResponse{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteTxResponse) ProtoMessage() {}
+
+func (x *ExecuteTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteTxResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteTxResponse) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExecuteTxResponse) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *ExecuteTxResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_UNKNOWN
+}
+
+// Deprecated: Marked as deprecated in quoteswap/quoteswap.proto.
+func (x *ExecuteTxResponse) GetSellTokenQty() float64 {
+	if x != nil {
+		return x.SellTokenQty
+	}
+	return 0
+}
+
+func (x *ExecuteTxResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in quoteswap/quoteswap.proto.
+func (x *ExecuteTxResponse) GetExecutedPrice() float64 {
+	if x != nil {
+		return x.ExecutedPrice
+	}
+	return 0
+}
+
+func (x *ExecuteTxResponse) GetSellTokenAmount() string {
+	if x != nil {
+		return x.SellTokenAmount
+	}
+	return ""
+}
+
+func (x *ExecuteTxResponse) GetExecutedPriceDecimal() string {
+	if x != nil {
+		return x.ExecutedPriceDecimal
+	}
+	return ""
+}
+
+func (x *ExecuteTxResponse) GetFill() *Fill {
+	if x != nil {
+		return x.Fill
+	}
+	return nil
+}
+
+type Fill struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AmountIn            string                 `protobuf:"bytes,1,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	AmountOut           string                 `protobuf:"bytes,2,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	AmountInDecimal     string                 `protobuf:"bytes,3,opt,name=amount_in_decimal,json=amountInDecimal,proto3" json:"amount_in_decimal,omitempty"`
+	AmountOutDecimal    string                 `protobuf:"bytes,4,opt,name=amount_out_decimal,json=amountOutDecimal,proto3" json:"amount_out_decimal,omitempty"`
+	EffectivePrice      string                 `protobuf:"bytes,5,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	RealizedSlippageBps int64                  `protobuf:"varint,6,opt,name=realized_slippage_bps,json=realizedSlippageBps,proto3" json:"realized_slippage_bps,omitempty"`
+	GasUsed             uint64                 `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	GasPaid             string                 `protobuf:"bytes,8,opt,name=gas_paid,json=gasPaid,proto3" json:"gas_paid,omitempty"`
+	BlockNumber         uint64                 `protobuf:"varint,9,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Pools               []string               `protobuf:"bytes,10,rep,name=pools,proto3" json:"pools,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Fill) Reset() {
+	*x = Fill{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fill) ProtoMessage() {}
+
+func (x *Fill) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fill.ProtoReflect.Descriptor instead.
+func (*Fill) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Fill) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *Fill) GetAmountOut() string {
+	if x != nil {
+		return x.AmountOut
+	}
+	return ""
+}
+
+func (x *Fill) GetAmountInDecimal() string {
+	if x != nil {
+		return x.AmountInDecimal
+	}
+	return ""
+}
+
+func (x *Fill) GetAmountOutDecimal() string {
+	if x != nil {
+		return x.AmountOutDecimal
+	}
+	return ""
+}
+
+func (x *Fill) GetEffectivePrice() string {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return ""
+}
+
+func (x *Fill) GetRealizedSlippageBps() int64 {
+	if x != nil {
+		return x.RealizedSlippageBps
+	}
+	return 0
+}
+
+func (x *Fill) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *Fill) GetGasPaid() string {
+	if x != nil {
+		return x.GasPaid
+	}
+	return ""
+}
+
+func (x *Fill) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *Fill) GetPools() []string {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type ListVenuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVenuesRequest) Reset() {
+	*x = ListVenuesRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVenuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuesRequest) ProtoMessage() {}
+
+func (x *ListVenuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuesRequest.ProtoReflect.Descriptor instead.
+func (*ListVenuesRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListVenuesRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+type ListVenuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venues        []*Venue               `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVenuesResponse) Reset() {
+	*x = ListVenuesResponse{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVenuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuesResponse) ProtoMessage() {}
+
+func (x *ListVenuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuesResponse.ProtoReflect.Descriptor instead.
+func (*ListVenuesResponse) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListVenuesResponse) GetVenues() []*Venue {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+type Venue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex           string                 `protobuf:"bytes,2,opt,name=dex,proto3" json:"dex,omitempty"`
+	Ready         bool                   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Error         *Error                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Venue) Reset() {
+	*x = Venue{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Venue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Venue) ProtoMessage() {}
+
+func (x *Venue) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Venue.ProtoReflect.Descriptor instead.
+func (*Venue) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Venue) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *Venue) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *Venue) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Venue) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetHistoricalQuotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *GetQuoteRequest       `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	FromBlock     uint64                 `protobuf:"varint,2,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	ToBlock       uint64                 `protobuf:"varint,3,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+	Step          uint64                 `protobuf:"varint,4,opt,name=step,proto3" json:"step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoricalQuotesRequest) Reset() {
+	*x = GetHistoricalQuotesRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoricalQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoricalQuotesRequest) ProtoMessage() {}
+
+func (x *GetHistoricalQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoricalQuotesRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoricalQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetHistoricalQuotesRequest) GetQuote() *GetQuoteRequest {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *GetHistoricalQuotesRequest) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *GetHistoricalQuotesRequest) GetToBlock() uint64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+func (x *GetHistoricalQuotesRequest) GetStep() uint64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+type HistoricalQuote struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BlockNumber      uint64                 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockTimestamp   uint64                 `protobuf:"varint,2,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	InAmount         string                 `protobuf:"bytes,3,opt,name=in_amount,json=inAmount,proto3" json:"in_amount,omitempty"`
+	OutAmount        string                 `protobuf:"bytes,4,opt,name=out_amount,json=outAmount,proto3" json:"out_amount,omitempty"`
+	OutAmountDecimal string                 `protobuf:"bytes,5,opt,name=out_amount_decimal,json=outAmountDecimal,proto3" json:"out_amount_decimal,omitempty"`
+	Price            string                 `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	Error            *Error                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HistoricalQuote) Reset() {
+	*x = HistoricalQuote{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoricalQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalQuote) ProtoMessage() {}
+
+func (x *HistoricalQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricalQuote.ProtoReflect.Descriptor instead.
+func (*HistoricalQuote) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HistoricalQuote) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *HistoricalQuote) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
+}
+
+func (x *HistoricalQuote) GetInAmount() string {
+	if x != nil {
+		return x.InAmount
+	}
+	return ""
+}
+
+func (x *HistoricalQuote) GetOutAmount() string {
+	if x != nil {
+		return x.OutAmount
+	}
+	return ""
+}
+
+func (x *HistoricalQuote) GetOutAmountDecimal() string {
+	if x != nil {
+		return x.OutAmountDecimal
+	}
+	return ""
+}
+
+func (x *HistoricalQuote) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *HistoricalQuote) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ListPoolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	TokenA        string                 `protobuf:"bytes,2,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
+	TokenB        string                 `protobuf:"bytes,3,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoolsRequest) Reset() {
+	*x = ListPoolsRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolsRequest) ProtoMessage() {}
+
+func (x *ListPoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolsRequest.ProtoReflect.Descriptor instead.
+func (*ListPoolsRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListPoolsRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ListPoolsRequest) GetTokenA() string {
+	if x != nil {
+		return x.TokenA
+	}
+	return ""
+}
+
+func (x *ListPoolsRequest) GetTokenB() string {
+	if x != nil {
+		return x.TokenB
+	}
+	return ""
+}
+
+type ListPoolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pools         []*Pool                `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoolsResponse) Reset() {
+	*x = ListPoolsResponse{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolsResponse) ProtoMessage() {}
+
+func (x *ListPoolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolsResponse.ProtoReflect.Descriptor instead.
+func (*ListPoolsResponse) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListPoolsResponse) GetPools() []*Pool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type Pool struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dex           string                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Fee           uint32                 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Token0        string                 `protobuf:"bytes,4,opt,name=token0,proto3" json:"token0,omitempty"`
+	Token1        string                 `protobuf:"bytes,5,opt,name=token1,proto3" json:"token1,omitempty"`
+	Reserve0      string                 `protobuf:"bytes,6,opt,name=reserve0,proto3" json:"reserve0,omitempty"`
+	Reserve1      string                 `protobuf:"bytes,7,opt,name=reserve1,proto3" json:"reserve1,omitempty"`
+	Liquidity     string                 `protobuf:"bytes,8,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	SqrtPriceX96  string                 `protobuf:"bytes,9,opt,name=sqrt_price_x96,json=sqrtPriceX96,proto3" json:"sqrt_price_x96,omitempty"`
+	Tick          int32                  `protobuf:"varint,10,opt,name=tick,proto3" json:"tick,omitempty"`
+	Price         string                 `protobuf:"bytes,11,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Pool) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *Pool) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pool) GetFee() uint32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Pool) GetToken0() string {
+	if x != nil {
+		return x.Token0
+	}
+	return ""
+}
+
+func (x *Pool) GetToken1() string {
+	if x != nil {
+		return x.Token1
+	}
+	return ""
+}
+
+func (x *Pool) GetReserve0() string {
+	if x != nil {
+		return x.Reserve0
+	}
+	return ""
+}
+
+func (x *Pool) GetReserve1() string {
+	if x != nil {
+		return x.Reserve1
+	}
+	return ""
+}
+
+func (x *Pool) GetLiquidity() string {
+	if x != nil {
+		return x.Liquidity
+	}
+	return ""
+}
+
+func (x *Pool) GetSqrtPriceX96() string {
+	if x != nil {
+		return x.SqrtPriceX96
+	}
+	return ""
+}
+
+func (x *Pool) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *Pool) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+type Error struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Error) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetTwapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex           string                 `protobuf:"bytes,2,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenA        string                 `protobuf:"bytes,3,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
+	TokenB        string                 `protobuf:"bytes,4,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	WindowSeconds uint32                 `protobuf:"varint,5,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Fee           uint32                 `protobuf:"varint,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTwapRequest) Reset() {
+	*x = GetTwapRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTwapRequest) ProtoMessage() {}
+
+func (x *GetTwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTwapRequest.ProtoReflect.Descriptor instead.
+func (*GetTwapRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTwapRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *GetTwapRequest) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *GetTwapRequest) GetTokenA() string {
+	if x != nil {
+		return x.TokenA
+	}
+	return ""
+}
+
+func (x *GetTwapRequest) GetTokenB() string {
+	if x != nil {
+		return x.TokenB
+	}
+	return ""
+}
+
+func (x *GetTwapRequest) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *GetTwapRequest) GetFee() uint32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+type GetTwapResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Pool                  *Pool                  `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	BlockNumber           uint64                 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockTimestamp        uint64                 `protobuf:"varint,3,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	WindowSeconds         uint32                 `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Price                 string                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Tick                  int32                  `protobuf:"varint,6,opt,name=tick,proto3" json:"tick,omitempty"`
+	HarmonicMeanLiquidity string                 `protobuf:"bytes,7,opt,name=harmonic_mean_liquidity,json=harmonicMeanLiquidity,proto3" json:"harmonic_mean_liquidity,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetTwapResponse) Reset() {
+	*x = GetTwapResponse{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTwapResponse) ProtoMessage() {}
+
+func (x *GetTwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTwapResponse.ProtoReflect.Descriptor instead.
+func (*GetTwapResponse) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTwapResponse) GetPool() *Pool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *GetTwapResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetTwapResponse) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
+}
+
+func (x *GetTwapResponse) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *GetTwapResponse) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *GetTwapResponse) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *GetTwapResponse) GetHarmonicMeanLiquidity() string {
+	if x != nil {
+		return x.HarmonicMeanLiquidity
+	}
+	return ""
+}
+
+type GetDepthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex           string                 `protobuf:"bytes,2,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenIn       string                 `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut      string                 `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	DepthBps      []uint32               `protobuf:"varint,5,rep,packed,name=depth_bps,json=depthBps,proto3" json:"depth_bps,omitempty"`
+	AmountsIn     []string               `protobuf:"bytes,6,rep,name=amounts_in,json=amountsIn,proto3" json:"amounts_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepthRequest) Reset() {
+	*x = GetDepthRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepthRequest) ProtoMessage() {}
+
+func (x *GetDepthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepthRequest.ProtoReflect.Descriptor instead.
+func (*GetDepthRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetDepthRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *GetDepthRequest) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *GetDepthRequest) GetTokenIn() string {
+	if x != nil {
+		return x.TokenIn
+	}
+	return ""
+}
+
+func (x *GetDepthRequest) GetTokenOut() string {
+	if x != nil {
+		return x.TokenOut
+	}
+	return ""
+}
+
+func (x *GetDepthRequest) GetDepthBps() []uint32 {
+	if x != nil {
+		return x.DepthBps
+	}
+	return nil
+}
+
+func (x *GetDepthRequest) GetAmountsIn() []string {
+	if x != nil {
+		return x.AmountsIn
+	}
+	return nil
+}
+
+type GetDepthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pool          *Pool                  `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	ReserveIn     string                 `protobuf:"bytes,2,opt,name=reserve_in,json=reserveIn,proto3" json:"reserve_in,omitempty"`
+	BlockNumber   uint64                 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Levels        []*DepthLevel          `protobuf:"bytes,4,rep,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepthResponse) Reset() {
+	*x = GetDepthResponse{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepthResponse) ProtoMessage() {}
+
+func (x *GetDepthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepthResponse.ProtoReflect.Descriptor instead.
+func (*GetDepthResponse) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetDepthResponse) GetPool() *Pool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *GetDepthResponse) GetReserveIn() string {
+	if x != nil {
+		return x.ReserveIn
+	}
+	return ""
+}
+
+func (x *GetDepthResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetDepthResponse) GetLevels() []*DepthLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type DepthLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DepthBps      uint32                 `protobuf:"varint,1,opt,name=depth_bps,json=depthBps,proto3" json:"depth_bps,omitempty"`
+	Quote         *GetQuoteResponse      `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepthLevel) Reset() {
+	*x = DepthLevel{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepthLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepthLevel) ProtoMessage() {}
+
+func (x *DepthLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepthLevel.ProtoReflect.Descriptor instead.
+func (*DepthLevel) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DepthLevel) GetDepthBps() uint32 {
+	if x != nil {
+		return x.DepthBps
+	}
+	return 0
+}
+
+func (x *DepthLevel) GetQuote() *GetQuoteResponse {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *DepthLevel) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CreateScheduledOrderRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Chain                  string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex                    string                 `protobuf:"bytes,2,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenIn                string                 `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut               string                 `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountIn               string                 `protobuf:"bytes,5,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	DurationSeconds        uint32                 `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Slices                 uint32                 `protobuf:"varint,7,opt,name=slices,proto3" json:"slices,omitempty"`
+	SlippageBps            uint32                 `protobuf:"varint,8,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	MaxSlicePriceImpactBps float64                `protobuf:"fixed64,9,opt,name=max_slice_price_impact_bps,json=maxSlicePriceImpactBps,proto3" json:"max_slice_price_impact_bps,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateScheduledOrderRequest) Reset() {
+	*x = CreateScheduledOrderRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduledOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledOrderRequest) ProtoMessage() {}
+
+func (x *CreateScheduledOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduledOrderRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateScheduledOrderRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *CreateScheduledOrderRequest) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *CreateScheduledOrderRequest) GetTokenIn() string {
+	if x != nil {
+		return x.TokenIn
+	}
+	return ""
+}
+
+func (x *CreateScheduledOrderRequest) GetTokenOut() string {
+	if x != nil {
+		return x.TokenOut
+	}
+	return ""
+}
+
+func (x *CreateScheduledOrderRequest) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *CreateScheduledOrderRequest) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateScheduledOrderRequest) GetSlices() uint32 {
+	if x != nil {
+		return x.Slices
+	}
+	return 0
+}
+
+func (x *CreateScheduledOrderRequest) GetSlippageBps() uint32 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
+func (x *CreateScheduledOrderRequest) GetMaxSlicePriceImpactBps() float64 {
+	if x != nil {
+		return x.MaxSlicePriceImpactBps
+	}
+	return 0
+}
+
+type ScheduledOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledOrderRequest) Reset() {
+	*x = ScheduledOrderRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledOrderRequest) ProtoMessage() {}
+
+func (x *ScheduledOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledOrderRequest.ProtoReflect.Descriptor instead.
+func (*ScheduledOrderRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScheduledOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ScheduledOrder struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status                 OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=quoteswap.OrderStatus" json:"status,omitempty"`
+	Chain                  string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex                    string                 `protobuf:"bytes,4,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenIn                string                 `protobuf:"bytes,5,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut               string                 `protobuf:"bytes,6,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountIn               string                 `protobuf:"bytes,7,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	DurationSeconds        uint32                 `protobuf:"varint,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Slices                 uint32                 `protobuf:"varint,9,opt,name=slices,proto3" json:"slices,omitempty"`
+	SlippageBps            uint32                 `protobuf:"varint,10,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	MaxSlicePriceImpactBps float64                `protobuf:"fixed64,11,opt,name=max_slice_price_impact_bps,json=maxSlicePriceImpactBps,proto3" json:"max_slice_price_impact_bps,omitempty"`
+	CreatedAt              uint64                 `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EndsAt                 uint64                 `protobuf:"varint,13,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	NextSliceAt            uint64                 `protobuf:"varint,14,opt,name=next_slice_at,json=nextSliceAt,proto3" json:"next_slice_at,omitempty"`
+	FilledIn               string                 `protobuf:"bytes,15,opt,name=filled_in,json=filledIn,proto3" json:"filled_in,omitempty"`
+	FilledOut              string                 `protobuf:"bytes,16,opt,name=filled_out,json=filledOut,proto3" json:"filled_out,omitempty"`
+	Fills                  []*OrderSlice          `protobuf:"bytes,17,rep,name=fills,proto3" json:"fills,omitempty"`
+	Error                  *Error                 `protobuf:"bytes,18,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ScheduledOrder) Reset() {
+	*x = ScheduledOrder{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledOrder) ProtoMessage() {}
+
+func (x *ScheduledOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledOrder.ProtoReflect.Descriptor instead.
+func (*ScheduledOrder) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ScheduledOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_UNKNOWN
+}
+
+func (x *ScheduledOrder) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetTokenIn() string {
+	if x != nil {
+		return x.TokenIn
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetTokenOut() string {
+	if x != nil {
+		return x.TokenOut
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ScheduledOrder) GetSlices() uint32 {
+	if x != nil {
+		return x.Slices
+	}
+	return 0
+}
+
+func (x *ScheduledOrder) GetSlippageBps() uint32 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
+func (x *ScheduledOrder) GetMaxSlicePriceImpactBps() float64 {
+	if x != nil {
+		return x.MaxSlicePriceImpactBps
+	}
+	return 0
+}
+
+func (x *ScheduledOrder) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ScheduledOrder) GetEndsAt() uint64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+func (x *ScheduledOrder) GetNextSliceAt() uint64 {
+	if x != nil {
+		return x.NextSliceAt
+	}
+	return 0
+}
+
+func (x *ScheduledOrder) GetFilledIn() string {
+	if x != nil {
+		return x.FilledIn
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetFilledOut() string {
+	if x != nil {
+		return x.FilledOut
+	}
+	return ""
+}
+
+func (x *ScheduledOrder) GetFills() []*OrderSlice {
+	if x != nil {
+		return x.Fills
+	}
+	return nil
+}
+
+func (x *ScheduledOrder) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type OrderSlice struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Index           uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ExecutedAt      uint64                 `protobuf:"varint,2,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	AmountIn        string                 `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	QuotedOut       string                 `protobuf:"bytes,4,opt,name=quoted_out,json=quotedOut,proto3" json:"quoted_out,omitempty"`
+	PriceImpactBps  string                 `protobuf:"bytes,5,opt,name=price_impact_bps,json=priceImpactBps,proto3" json:"price_impact_bps,omitempty"`
+	TransactionHash string                 `protobuf:"bytes,6,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Status          TransactionStatus      `protobuf:"varint,7,opt,name=status,proto3,enum=quoteswap.TransactionStatus" json:"status,omitempty"`
+	Fill            *Fill                  `protobuf:"bytes,8,opt,name=fill,proto3" json:"fill,omitempty"`
+	Error           *Error                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Dex             string                 `protobuf:"bytes,10,opt,name=dex,proto3" json:"dex,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderSlice) Reset() {
+	*x = OrderSlice{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderSlice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderSlice) ProtoMessage() {}
+
+func (x *OrderSlice) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderSlice.ProtoReflect.Descriptor instead.
+func (*OrderSlice) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *OrderSlice) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *OrderSlice) GetExecutedAt() uint64 {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return 0
+}
+
+func (x *OrderSlice) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *OrderSlice) GetQuotedOut() string {
+	if x != nil {
+		return x.QuotedOut
+	}
+	return ""
+}
+
+func (x *OrderSlice) GetPriceImpactBps() string {
+	if x != nil {
+		return x.PriceImpactBps
+	}
+	return ""
+}
+
+func (x *OrderSlice) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *OrderSlice) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_UNKNOWN
+}
+
+func (x *OrderSlice) GetFill() *Fill {
+	if x != nil {
+		return x.Fill
+	}
+	return nil
+}
+
+func (x *OrderSlice) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *OrderSlice) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+type PlaceLimitOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex           string                 `protobuf:"bytes,2,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenIn       string                 `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut      string                 `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountIn      string                 `protobuf:"bytes,5,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	LimitPrice    string                 `protobuf:"bytes,6,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	ExpiresAt     uint64                 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SlippageBps   uint32                 `protobuf:"varint,8,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceLimitOrderRequest) Reset() {
+	*x = PlaceLimitOrderRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceLimitOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceLimitOrderRequest) ProtoMessage() {}
+
+func (x *PlaceLimitOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceLimitOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceLimitOrderRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PlaceLimitOrderRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *PlaceLimitOrderRequest) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *PlaceLimitOrderRequest) GetTokenIn() string {
+	if x != nil {
+		return x.TokenIn
+	}
+	return ""
+}
+
+func (x *PlaceLimitOrderRequest) GetTokenOut() string {
+	if x != nil {
+		return x.TokenOut
+	}
+	return ""
+}
+
+func (x *PlaceLimitOrderRequest) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *PlaceLimitOrderRequest) GetLimitPrice() string {
+	if x != nil {
+		return x.LimitPrice
+	}
+	return ""
+}
+
+func (x *PlaceLimitOrderRequest) GetExpiresAt() uint64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *PlaceLimitOrderRequest) GetSlippageBps() uint32 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
+type LimitOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitOrderRequest) Reset() {
+	*x = LimitOrderRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitOrderRequest) ProtoMessage() {}
+
+func (x *LimitOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitOrderRequest.ProtoReflect.Descriptor instead.
+func (*LimitOrderRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LimitOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type LimitOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=quoteswap.OrderStatus" json:"status,omitempty"`
+	Chain         string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex           string                 `protobuf:"bytes,4,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenIn       string                 `protobuf:"bytes,5,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut      string                 `protobuf:"bytes,6,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountIn      string                 `protobuf:"bytes,7,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	LimitPrice    string                 `protobuf:"bytes,8,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	MinAmountOut  string                 `protobuf:"bytes,9,opt,name=min_amount_out,json=minAmountOut,proto3" json:"min_amount_out,omitempty"`
+	SlippageBps   uint32                 `protobuf:"varint,10,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	CreatedAt     uint64                 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     uint64                 `protobuf:"varint,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CheckedBlock  uint64                 `protobuf:"varint,13,opt,name=checked_block,json=checkedBlock,proto3" json:"checked_block,omitempty"`
+	LastQuotedOut string                 `protobuf:"bytes,14,opt,name=last_quoted_out,json=lastQuotedOut,proto3" json:"last_quoted_out,omitempty"`
+	Executions    []*OrderSlice          `protobuf:"bytes,15,rep,name=executions,proto3" json:"executions,omitempty"`
+	Error         *Error                 `protobuf:"bytes,16,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitOrder) Reset() {
+	*x = LimitOrder{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitOrder) ProtoMessage() {}
+
+func (x *LimitOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitOrder.ProtoReflect.Descriptor instead.
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LimitOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LimitOrder) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_UNKNOWN
+}
+
+func (x *LimitOrder) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *LimitOrder) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *LimitOrder) GetTokenIn() string {
+	if x != nil {
+		return x.TokenIn
+	}
+	return ""
+}
+
+func (x *LimitOrder) GetTokenOut() string {
+	if x != nil {
+		return x.TokenOut
+	}
+	return ""
+}
+
+func (x *LimitOrder) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *LimitOrder) GetLimitPrice() string {
+	if x != nil {
+		return x.LimitPrice
+	}
+	return ""
+}
+
+func (x *LimitOrder) GetMinAmountOut() string {
+	if x != nil {
+		return x.MinAmountOut
+	}
+	return ""
+}
+
+func (x *LimitOrder) GetSlippageBps() uint32 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
+func (x *LimitOrder) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LimitOrder) GetExpiresAt() uint64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *LimitOrder) GetCheckedBlock() uint64 {
+	if x != nil {
+		return x.CheckedBlock
+	}
+	return 0
+}
+
+func (x *LimitOrder) GetLastQuotedOut() string {
+	if x != nil {
+		return x.LastQuotedOut
+	}
+	return ""
+}
+
+func (x *LimitOrder) GetExecutions() []*OrderSlice {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *LimitOrder) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CreateTriggerRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Chain             string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex               string                 `protobuf:"bytes,2,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenIn           string                 `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut          string                 `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountIn          string                 `protobuf:"bytes,5,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	Kind              TriggerKind            `protobuf:"varint,6,opt,name=kind,proto3,enum=quoteswap.TriggerKind" json:"kind,omitempty"`
+	TriggerPrice      string                 `protobuf:"bytes,7,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	TwapWindowSeconds uint32                 `protobuf:"varint,8,opt,name=twap_window_seconds,json=twapWindowSeconds,proto3" json:"twap_window_seconds,omitempty"`
+	SlippageBps       uint32                 `protobuf:"varint,9,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	ExpiresAt         uint64                 `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateTriggerRequest) Reset() {
+	*x = CreateTriggerRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTriggerRequest) ProtoMessage() {}
+
+func (x *CreateTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTriggerRequest.ProtoReflect.Descriptor instead.
+func (*CreateTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateTriggerRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *CreateTriggerRequest) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *CreateTriggerRequest) GetTokenIn() string {
+	if x != nil {
+		return x.TokenIn
+	}
+	return ""
+}
+
+func (x *CreateTriggerRequest) GetTokenOut() string {
+	if x != nil {
+		return x.TokenOut
+	}
+	return ""
+}
+
+func (x *CreateTriggerRequest) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *CreateTriggerRequest) GetKind() TriggerKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TriggerKind_TRIGGER_UNKNOWN
+}
+
+func (x *CreateTriggerRequest) GetTriggerPrice() string {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return ""
+}
+
+func (x *CreateTriggerRequest) GetTwapWindowSeconds() uint32 {
+	if x != nil {
+		return x.TwapWindowSeconds
+	}
+	return 0
+}
+
+func (x *CreateTriggerRequest) GetSlippageBps() uint32 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
+func (x *CreateTriggerRequest) GetExpiresAt() uint64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type TriggerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerRequest) Reset() {
+	*x = TriggerRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRequest) ProtoMessage() {}
+
+func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRequest.ProtoReflect.Descriptor instead.
+func (*TriggerRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TriggerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Trigger struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status            OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=quoteswap.OrderStatus" json:"status,omitempty"`
+	Kind              TriggerKind            `protobuf:"varint,3,opt,name=kind,proto3,enum=quoteswap.TriggerKind" json:"kind,omitempty"`
+	Chain             string                 `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex               string                 `protobuf:"bytes,5,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenIn           string                 `protobuf:"bytes,6,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut          string                 `protobuf:"bytes,7,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountIn          string                 `protobuf:"bytes,8,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	TriggerPrice      string                 `protobuf:"bytes,9,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	TwapWindowSeconds uint32                 `protobuf:"varint,10,opt,name=twap_window_seconds,json=twapWindowSeconds,proto3" json:"twap_window_seconds,omitempty"`
+	SlippageBps       uint32                 `protobuf:"varint,11,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	CreatedAt         uint64                 `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt         uint64                 `protobuf:"varint,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Evaluations       uint64                 `protobuf:"varint,14,opt,name=evaluations,proto3" json:"evaluations,omitempty"`
+	RecentEvaluations []*TriggerEvaluation   `protobuf:"bytes,15,rep,name=recent_evaluations,json=recentEvaluations,proto3" json:"recent_evaluations,omitempty"`
+	Executions        []*OrderSlice          `protobuf:"bytes,16,rep,name=executions,proto3" json:"executions,omitempty"`
+	Error             *Error                 `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Trigger) Reset() {
+	*x = Trigger{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trigger) ProtoMessage() {}
+
+func (x *Trigger) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
+func (*Trigger) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Trigger) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Trigger) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_UNKNOWN
+}
+
+func (x *Trigger) GetKind() TriggerKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TriggerKind_TRIGGER_UNKNOWN
+}
+
+func (x *Trigger) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *Trigger) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *Trigger) GetTokenIn() string {
+	if x != nil {
+		return x.TokenIn
+	}
+	return ""
+}
+
+func (x *Trigger) GetTokenOut() string {
+	if x != nil {
+		return x.TokenOut
+	}
+	return ""
+}
+
+func (x *Trigger) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *Trigger) GetTriggerPrice() string {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return ""
+}
+
+func (x *Trigger) GetTwapWindowSeconds() uint32 {
+	if x != nil {
+		return x.TwapWindowSeconds
+	}
+	return 0
+}
+
+func (x *Trigger) GetSlippageBps() uint32 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
+func (x *Trigger) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Trigger) GetExpiresAt() uint64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Trigger) GetEvaluations() uint64 {
+	if x != nil {
+		return x.Evaluations
+	}
+	return 0
+}
+
+func (x *Trigger) GetRecentEvaluations() []*TriggerEvaluation {
+	if x != nil {
+		return x.RecentEvaluations
+	}
+	return nil
+}
+
+func (x *Trigger) GetExecutions() []*OrderSlice {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *Trigger) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type TriggerEvaluation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TriggerId      string                 `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	BlockNumber    uint64                 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockTimestamp uint64                 `protobuf:"varint,3,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	EvaluatedAt    uint64                 `protobuf:"varint,4,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	QuotedOut      string                 `protobuf:"bytes,5,opt,name=quoted_out,json=quotedOut,proto3" json:"quoted_out,omitempty"`
+	Price          string                 `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	TwapPrice      string                 `protobuf:"bytes,7,opt,name=twap_price,json=twapPrice,proto3" json:"twap_price,omitempty"`
+	Met            bool                   `protobuf:"varint,8,opt,name=met,proto3" json:"met,omitempty"`
+	Error          *Error                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TriggerEvaluation) Reset() {
+	*x = TriggerEvaluation{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEvaluation) ProtoMessage() {}
+
+func (x *TriggerEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEvaluation.ProtoReflect.Descriptor instead.
+func (*TriggerEvaluation) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TriggerEvaluation) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+func (x *TriggerEvaluation) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *TriggerEvaluation) GetBlockTimestamp() uint64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
+}
+
+func (x *TriggerEvaluation) GetEvaluatedAt() uint64 {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return 0
+}
+
+func (x *TriggerEvaluation) GetQuotedOut() string {
+	if x != nil {
+		return x.QuotedOut
+	}
+	return ""
+}
+
+func (x *TriggerEvaluation) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *TriggerEvaluation) GetTwapPrice() string {
+	if x != nil {
+		return x.TwapPrice
+	}
+	return ""
+}
+
+func (x *TriggerEvaluation) GetMet() bool {
+	if x != nil {
+		return x.Met
+	}
+	return false
+}
+
+func (x *TriggerEvaluation) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CreateDcaPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex           string                 `protobuf:"bytes,2,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenIn       string                 `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut      string                 `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountIn      string                 `protobuf:"bytes,5,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	Schedule      string                 `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	SlippageBps   uint32                 `protobuf:"varint,7,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	MaxPrice      string                 `protobuf:"bytes,8,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	EndsAt        uint64                 `protobuf:"varint,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDcaPlanRequest) Reset() {
+	*x = CreateDcaPlanRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDcaPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDcaPlanRequest) ProtoMessage() {}
+
+func (x *CreateDcaPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDcaPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateDcaPlanRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateDcaPlanRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *CreateDcaPlanRequest) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *CreateDcaPlanRequest) GetTokenIn() string {
+	if x != nil {
+		return x.TokenIn
+	}
+	return ""
+}
+
+func (x *CreateDcaPlanRequest) GetTokenOut() string {
+	if x != nil {
+		return x.TokenOut
+	}
+	return ""
+}
+
+func (x *CreateDcaPlanRequest) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *CreateDcaPlanRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CreateDcaPlanRequest) GetSlippageBps() uint32 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
+func (x *CreateDcaPlanRequest) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *CreateDcaPlanRequest) GetEndsAt() uint64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+type DcaPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DcaPlanRequest) Reset() {
+	*x = DcaPlanRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DcaPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DcaPlanRequest) ProtoMessage() {}
+
+func (x *DcaPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DcaPlanRequest.ProtoReflect.Descriptor instead.
+func (*DcaPlanRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DcaPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDcaPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	IncludeDone   bool                   `protobuf:"varint,2,opt,name=include_done,json=includeDone,proto3" json:"include_done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDcaPlansRequest) Reset() {
+	*x = ListDcaPlansRequest{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDcaPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDcaPlansRequest) ProtoMessage() {}
+
+func (x *ListDcaPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDcaPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListDcaPlansRequest) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListDcaPlansRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ListDcaPlansRequest) GetIncludeDone() bool {
+	if x != nil {
+		return x.IncludeDone
+	}
+	return false
+}
+
+type ListDcaPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*DcaPlan             `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDcaPlansResponse) Reset() {
+	*x = ListDcaPlansResponse{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDcaPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDcaPlansResponse) ProtoMessage() {}
+
+func (x *ListDcaPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDcaPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListDcaPlansResponse) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListDcaPlansResponse) GetPlans() []*DcaPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type DcaPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=quoteswap.OrderStatus" json:"status,omitempty"`
+	Chain         string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Dex           string                 `protobuf:"bytes,4,opt,name=dex,proto3" json:"dex,omitempty"`
+	TokenIn       string                 `protobuf:"bytes,5,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut      string                 `protobuf:"bytes,6,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountIn      string                 `protobuf:"bytes,7,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	Schedule      string                 `protobuf:"bytes,8,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	SlippageBps   uint32                 `protobuf:"varint,9,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	MaxPrice      string                 `protobuf:"bytes,10,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	CreatedAt     uint64                 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EndsAt        uint64                 `protobuf:"varint,12,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	NextRunAt     uint64                 `protobuf:"varint,13,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	Spent         string                 `protobuf:"bytes,14,opt,name=spent,proto3" json:"spent,omitempty"`
+	Bought        string                 `protobuf:"bytes,15,opt,name=bought,proto3" json:"bought,omitempty"`
+	Runs          []*OrderSlice          `protobuf:"bytes,16,rep,name=runs,proto3" json:"runs,omitempty"`
+	Error         *Error                 `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DcaPlan) Reset() {
+	*x = DcaPlan{}
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DcaPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DcaPlan) ProtoMessage() {}
+
+func (x *DcaPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_quoteswap_quoteswap_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DcaPlan.ProtoReflect.Descriptor instead.
+func (*DcaPlan) Descriptor() ([]byte, []int) {
+	return file_quoteswap_quoteswap_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DcaPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_UNKNOWN
+}
+
+func (x *DcaPlan) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetTokenIn() string {
+	if x != nil {
+		return x.TokenIn
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetTokenOut() string {
+	if x != nil {
+		return x.TokenOut
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetSlippageBps() uint32 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
+func (x *DcaPlan) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DcaPlan) GetEndsAt() uint64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+func (x *DcaPlan) GetNextRunAt() uint64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
+}
+
+func (x *DcaPlan) GetSpent() string {
+	if x != nil {
+		return x.Spent
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetBought() string {
+	if x != nil {
+		return x.Bought
+	}
+	return ""
+}
+
+func (x *DcaPlan) GetRuns() []*OrderSlice {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *DcaPlan) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
 }
 
 var File_quoteswap_quoteswap_proto protoreflect.FileDescriptor
 
 const file_quoteswap_quoteswap_proto_rawDesc = "" +
 	"\n" +
-	"\x19quoteswap/quoteswap.proto\x12\tquoteswap\"\xac\x01\n" +
+	"\x19quoteswap/quoteswap.proto\x12\tquoteswap\"\xe8\x02\n" +
 	"\x0fGetQuoteRequest\x12\x19\n" +
 	"\btoken_in\x18\x01 \x01(\tR\atokenIn\x12\x1b\n" +
-	"\ttoken_out\x18\x02 \x01(\tR\btokenOut\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x04R\x06amount\x12\x10\n" +
+	"\ttoken_out\x18\x02 \x01(\tR\btokenOut\x12\x1a\n" +
+	"\x06amount\x18\x03 \x01(\x04B\x02\x18\x01R\x06amount\x12\x10\n" +
 	"\x03dex\x18\x04 \x01(\tR\x03dex\x12!\n" +
 	"\fslippage_bps\x18\x05 \x01(\rR\vslippageBps\x12\x14\n" +
-	"\x05chain\x18\x06 \x01(\tR\x05chain\"\xdd\x01\n" +
+	"\x05chain\x18\x06 \x01(\tR\x05chain\x12%\n" +
+	"\x0eamount_decimal\x18\a \x01(\tR\ramountDecimal\x12\x1b\n" +
+	"\tamount_in\x18\b \x01(\tR\bamountIn\x120\n" +
+	"\x14max_staleness_blocks\x18\t \x01(\rR\x12maxStalenessBlocks\x12!\n" +
+	"\fblock_number\x18\n" +
+	" \x01(\x04R\vblockNumber\x12\x1d\n" +
+	"\n" +
+	"block_hash\x18\v \x01(\tR\tblockHash\"\xee\x05\n" +
 	"\x10GetQuoteResponse\x12\x1f\n" +
 	"\vinput_token\x18\x01 \x01(\tR\n" +
 	"inputToken\x12\x1b\n" +
@@ -443,27 +4071,399 @@ const file_quoteswap_quoteswap_proto_rawDesc = "" +
 	"out_amount\x18\x04 \x01(\tR\toutAmount\x12!\n" +
 	"\fslippage_bps\x18\x05 \x01(\x05R\vslippageBps\x12\x10\n" +
 	"\x03dex\x18\x06 \x01(\tR\x03dex\x12\x14\n" +
-	"\x05chain\x18\a \x01(\tR\x05chain\"Z\n" +
+	"\x05chain\x18\a \x01(\tR\x05chain\x12*\n" +
+	"\x11in_amount_decimal\x18\b \x01(\tR\x0finAmountDecimal\x12,\n" +
+	"\x12out_amount_decimal\x18\t \x01(\tR\x10outAmountDecimal\x12\x1b\n" +
+	"\tcache_hit\x18\n" +
+	" \x01(\bR\bcacheHit\x12!\n" +
+	"\fblock_number\x18\v \x01(\x04R\vblockNumber\x12\x1d\n" +
+	"\n" +
+	"block_hash\x18\f \x01(\tR\tblockHash\x12'\n" +
+	"\x0fblock_timestamp\x18\r \x01(\x04R\x0eblockTimestamp\x12/\n" +
+	"\x14sqrt_price_x96_after\x18\x0e \x01(\tR\x11sqrtPriceX96After\x12:\n" +
+	"\x19initialized_ticks_crossed\x18\x0f \x01(\rR\x17initializedTicksCrossed\x12#\n" +
+	"\x04pool\x18\x10 \x01(\v2\x0f.quoteswap.PoolR\x04pool\x12\x1b\n" +
+	"\tmid_price\x18\x11 \x01(\tR\bmidPrice\x12'\n" +
+	"\x0fexecution_price\x18\x12 \x01(\tR\x0eexecutionPrice\x12(\n" +
+	"\x10price_impact_bps\x18\x13 \x01(\tR\x0epriceImpactBps\x12+\n" +
+	"\x06splits\x18\x14 \x03(\v2\x13.quoteswap.SplitLegR\x06splits\"k\n" +
+	"\bSplitLeg\x12#\n" +
+	"\x04pool\x18\x01 \x01(\v2\x0f.quoteswap.PoolR\x04pool\x12\x1b\n" +
+	"\tin_amount\x18\x02 \x01(\tR\binAmount\x12\x1d\n" +
+	"\n" +
+	"out_amount\x18\x03 \x01(\tR\toutAmount\"J\n" +
+	"\x14BatchGetQuoteRequest\x122\n" +
+	"\x06quotes\x18\x01 \x03(\v2\x1a.quoteswap.GetQuoteRequestR\x06quotes\"N\n" +
+	"\x15BatchGetQuoteResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.quoteswap.BatchQuoteResultR\aresults\"m\n" +
+	"\x10BatchQuoteResult\x121\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1b.quoteswap.GetQuoteResponseR\x05quote\x12&\n" +
+	"\x05error\x18\x02 \x01(\v2\x10.quoteswap.ErrorR\x05error\"A\n" +
+	"\x0fGetTokenRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\xd4\x01\n" +
+	"\x05Token\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bdecimals\x18\x05 \x01(\rR\bdecimals\x12!\n" +
+	"\ftotal_supply\x18\x06 \x01(\tR\vtotalSupply\x120\n" +
+	"\x14total_supply_decimal\x18\a \x01(\tR\x12totalSupplyDecimal\":\n" +
+	"\bTokenRef\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\xec\x01\n" +
+	"\x12GetBalancesRequest\x12\x16\n" +
+	"\x06chains\x18\x01 \x03(\tR\x06chains\x12+\n" +
+	"\x06tokens\x18\x02 \x03(\v2\x13.quoteswap.TokenRefR\x06tokens\x12Q\n" +
+	"\fquote_tokens\x18\x03 \x03(\v2..quoteswap.GetBalancesRequest.QuoteTokensEntryR\vquoteTokens\x1a>\n" +
+	"\x10QuoteTokensEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\x13GetBalancesResponse\x123\n" +
+	"\awallets\x18\x01 \x03(\v2\x19.quoteswap.WalletBalancesR\awallets\"\x80\x02\n" +
+	"\x0eWalletBalances\x12\x16\n" +
+	"\x06wallet\x18\x01 \x01(\tR\x06wallet\x12.\n" +
+	"\bbalances\x18\x02 \x03(\v2\x12.quoteswap.BalanceR\bbalances\x12`\n" +
+	"\x13total_value_decimal\x18\x03 \x03(\v20.quoteswap.WalletBalances.TotalValueDecimalEntryR\x11totalValueDecimal\x1aD\n" +
+	"\x16TotalValueDecimalEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x90\x02\n" +
+	"\aBalance\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12%\n" +
+	"\x0eamount_decimal\x18\x05 \x01(\tR\ramountDecimal\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\tR\x05value\x12#\n" +
+	"\rvalue_decimal\x18\a \x01(\tR\fvalueDecimal\x12\x1f\n" +
+	"\vvalue_token\x18\b \x01(\tR\n" +
+	"valueToken\x12&\n" +
+	"\x05error\x18\t \x01(\v2\x10.quoteswap.ErrorR\x05error\"\x84\x01\n" +
 	"\x10ExecuteTxRequest\x12F\n" +
-	"\x10quoting_response\x18\x01 \x01(\v2\x1b.quoteswap.GetQuoteResponseR\x0fquotingResponse\"\xe9\x01\n" +
+	"\x10quoting_response\x18\x01 \x01(\v2\x1b.quoteswap.GetQuoteResponseR\x0fquotingResponse\x12(\n" +
+	"\x10wait_for_receipt\x18\x02 \x01(\bR\x0ewaitForReceipt\"\xf8\x02\n" +
 	"\x11ExecuteTxResponse\x12)\n" +
 	"\x10transaction_hash\x18\x01 \x01(\tR\x0ftransactionHash\x124\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x1c.quoteswap.TransactionStatusR\x06status\x12$\n" +
-	"\x0esell_token_qty\x18\x03 \x01(\x01R\fsellTokenQty\x12&\n" +
-	"\x05error\x18\x04 \x01(\v2\x10.quoteswap.ErrorR\x05error\x12%\n" +
-	"\x0eexecuted_price\x18\x05 \x01(\x01R\rexecutedPrice\"5\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.quoteswap.TransactionStatusR\x06status\x12(\n" +
+	"\x0esell_token_qty\x18\x03 \x01(\x01B\x02\x18\x01R\fsellTokenQty\x12&\n" +
+	"\x05error\x18\x04 \x01(\v2\x10.quoteswap.ErrorR\x05error\x12)\n" +
+	"\x0eexecuted_price\x18\x05 \x01(\x01B\x02\x18\x01R\rexecutedPrice\x12*\n" +
+	"\x11sell_token_amount\x18\x06 \x01(\tR\x0fsellTokenAmount\x124\n" +
+	"\x16executed_price_decimal\x18\a \x01(\tR\x14executedPriceDecimal\x12#\n" +
+	"\x04fill\x18\b \x01(\v2\x0f.quoteswap.FillR\x04fill\"\xe8\x02\n" +
+	"\x04Fill\x12\x1b\n" +
+	"\tamount_in\x18\x01 \x01(\tR\bamountIn\x12\x1d\n" +
+	"\n" +
+	"amount_out\x18\x02 \x01(\tR\tamountOut\x12*\n" +
+	"\x11amount_in_decimal\x18\x03 \x01(\tR\x0famountInDecimal\x12,\n" +
+	"\x12amount_out_decimal\x18\x04 \x01(\tR\x10amountOutDecimal\x12'\n" +
+	"\x0feffective_price\x18\x05 \x01(\tR\x0eeffectivePrice\x122\n" +
+	"\x15realized_slippage_bps\x18\x06 \x01(\x03R\x13realizedSlippageBps\x12\x19\n" +
+	"\bgas_used\x18\a \x01(\x04R\agasUsed\x12\x19\n" +
+	"\bgas_paid\x18\b \x01(\tR\agasPaid\x12!\n" +
+	"\fblock_number\x18\t \x01(\x04R\vblockNumber\x12\x14\n" +
+	"\x05pools\x18\n" +
+	" \x03(\tR\x05pools\")\n" +
+	"\x11ListVenuesRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\">\n" +
+	"\x12ListVenuesResponse\x12(\n" +
+	"\x06venues\x18\x01 \x03(\v2\x10.quoteswap.VenueR\x06venues\"m\n" +
+	"\x05Venue\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x02 \x01(\tR\x03dex\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\bR\x05ready\x12&\n" +
+	"\x05error\x18\x04 \x01(\v2\x10.quoteswap.ErrorR\x05error\"\x9c\x01\n" +
+	"\x1aGetHistoricalQuotesRequest\x120\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1a.quoteswap.GetQuoteRequestR\x05quote\x12\x1d\n" +
+	"\n" +
+	"from_block\x18\x02 \x01(\x04R\tfromBlock\x12\x19\n" +
+	"\bto_block\x18\x03 \x01(\x04R\atoBlock\x12\x12\n" +
+	"\x04step\x18\x04 \x01(\x04R\x04step\"\x85\x02\n" +
+	"\x0fHistoricalQuote\x12!\n" +
+	"\fblock_number\x18\x01 \x01(\x04R\vblockNumber\x12'\n" +
+	"\x0fblock_timestamp\x18\x02 \x01(\x04R\x0eblockTimestamp\x12\x1b\n" +
+	"\tin_amount\x18\x03 \x01(\tR\binAmount\x12\x1d\n" +
+	"\n" +
+	"out_amount\x18\x04 \x01(\tR\toutAmount\x12,\n" +
+	"\x12out_amount_decimal\x18\x05 \x01(\tR\x10outAmountDecimal\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\tR\x05price\x12&\n" +
+	"\x05error\x18\a \x01(\v2\x10.quoteswap.ErrorR\x05error\"Z\n" +
+	"\x10ListPoolsRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x17\n" +
+	"\atoken_a\x18\x02 \x01(\tR\x06tokenA\x12\x17\n" +
+	"\atoken_b\x18\x03 \x01(\tR\x06tokenB\":\n" +
+	"\x11ListPoolsResponse\x12%\n" +
+	"\x05pools\x18\x01 \x03(\v2\x0f.quoteswap.PoolR\x05pools\"\x9a\x02\n" +
+	"\x04Pool\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\tR\x03dex\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\rR\x03fee\x12\x16\n" +
+	"\x06token0\x18\x04 \x01(\tR\x06token0\x12\x16\n" +
+	"\x06token1\x18\x05 \x01(\tR\x06token1\x12\x1a\n" +
+	"\breserve0\x18\x06 \x01(\tR\breserve0\x12\x1a\n" +
+	"\breserve1\x18\a \x01(\tR\breserve1\x12\x1c\n" +
+	"\tliquidity\x18\b \x01(\tR\tliquidity\x12$\n" +
+	"\x0esqrt_price_x96\x18\t \x01(\tR\fsqrtPriceX96\x12\x12\n" +
+	"\x04tick\x18\n" +
+	" \x01(\x05R\x04tick\x12\x14\n" +
+	"\x05price\x18\v \x01(\tR\x05price\"5\n" +
 	"\x05Error\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*F\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x01\n" +
+	"\x0eGetTwapRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x02 \x01(\tR\x03dex\x12\x17\n" +
+	"\atoken_a\x18\x03 \x01(\tR\x06tokenA\x12\x17\n" +
+	"\atoken_b\x18\x04 \x01(\tR\x06tokenB\x12%\n" +
+	"\x0ewindow_seconds\x18\x05 \x01(\rR\rwindowSeconds\x12\x10\n" +
+	"\x03fee\x18\x06 \x01(\rR\x03fee\"\x8b\x02\n" +
+	"\x0fGetTwapResponse\x12#\n" +
+	"\x04pool\x18\x01 \x01(\v2\x0f.quoteswap.PoolR\x04pool\x12!\n" +
+	"\fblock_number\x18\x02 \x01(\x04R\vblockNumber\x12'\n" +
+	"\x0fblock_timestamp\x18\x03 \x01(\x04R\x0eblockTimestamp\x12%\n" +
+	"\x0ewindow_seconds\x18\x04 \x01(\rR\rwindowSeconds\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\tR\x05price\x12\x12\n" +
+	"\x04tick\x18\x06 \x01(\x05R\x04tick\x126\n" +
+	"\x17harmonic_mean_liquidity\x18\a \x01(\tR\x15harmonicMeanLiquidity\"\xad\x01\n" +
+	"\x0fGetDepthRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x02 \x01(\tR\x03dex\x12\x19\n" +
+	"\btoken_in\x18\x03 \x01(\tR\atokenIn\x12\x1b\n" +
+	"\ttoken_out\x18\x04 \x01(\tR\btokenOut\x12\x1b\n" +
+	"\tdepth_bps\x18\x05 \x03(\rR\bdepthBps\x12\x1d\n" +
+	"\n" +
+	"amounts_in\x18\x06 \x03(\tR\tamountsIn\"\xa8\x01\n" +
+	"\x10GetDepthResponse\x12#\n" +
+	"\x04pool\x18\x01 \x01(\v2\x0f.quoteswap.PoolR\x04pool\x12\x1d\n" +
+	"\n" +
+	"reserve_in\x18\x02 \x01(\tR\treserveIn\x12!\n" +
+	"\fblock_number\x18\x03 \x01(\x04R\vblockNumber\x12-\n" +
+	"\x06levels\x18\x04 \x03(\v2\x15.quoteswap.DepthLevelR\x06levels\"\x84\x01\n" +
+	"\n" +
+	"DepthLevel\x12\x1b\n" +
+	"\tdepth_bps\x18\x01 \x01(\rR\bdepthBps\x121\n" +
+	"\x05quote\x18\x02 \x01(\v2\x1b.quoteswap.GetQuoteResponseR\x05quote\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x10.quoteswap.ErrorR\x05error\"\xbc\x02\n" +
+	"\x1bCreateScheduledOrderRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x02 \x01(\tR\x03dex\x12\x19\n" +
+	"\btoken_in\x18\x03 \x01(\tR\atokenIn\x12\x1b\n" +
+	"\ttoken_out\x18\x04 \x01(\tR\btokenOut\x12\x1b\n" +
+	"\tamount_in\x18\x05 \x01(\tR\bamountIn\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\rR\x0fdurationSeconds\x12\x16\n" +
+	"\x06slices\x18\a \x01(\rR\x06slices\x12!\n" +
+	"\fslippage_bps\x18\b \x01(\rR\vslippageBps\x12:\n" +
+	"\x1amax_slice_price_impact_bps\x18\t \x01(\x01R\x16maxSlicePriceImpactBps\"'\n" +
+	"\x15ScheduledOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xdc\x04\n" +
+	"\x0eScheduledOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.quoteswap.OrderStatusR\x06status\x12\x14\n" +
+	"\x05chain\x18\x03 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x04 \x01(\tR\x03dex\x12\x19\n" +
+	"\btoken_in\x18\x05 \x01(\tR\atokenIn\x12\x1b\n" +
+	"\ttoken_out\x18\x06 \x01(\tR\btokenOut\x12\x1b\n" +
+	"\tamount_in\x18\a \x01(\tR\bamountIn\x12)\n" +
+	"\x10duration_seconds\x18\b \x01(\rR\x0fdurationSeconds\x12\x16\n" +
+	"\x06slices\x18\t \x01(\rR\x06slices\x12!\n" +
+	"\fslippage_bps\x18\n" +
+	" \x01(\rR\vslippageBps\x12:\n" +
+	"\x1amax_slice_price_impact_bps\x18\v \x01(\x01R\x16maxSlicePriceImpactBps\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x04R\tcreatedAt\x12\x17\n" +
+	"\aends_at\x18\r \x01(\x04R\x06endsAt\x12\"\n" +
+	"\rnext_slice_at\x18\x0e \x01(\x04R\vnextSliceAt\x12\x1b\n" +
+	"\tfilled_in\x18\x0f \x01(\tR\bfilledIn\x12\x1d\n" +
+	"\n" +
+	"filled_out\x18\x10 \x01(\tR\tfilledOut\x12+\n" +
+	"\x05fills\x18\x11 \x03(\v2\x15.quoteswap.OrderSliceR\x05fills\x12&\n" +
+	"\x05error\x18\x12 \x01(\v2\x10.quoteswap.ErrorR\x05error\"\xe9\x02\n" +
+	"\n" +
+	"OrderSlice\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12\x1f\n" +
+	"\vexecuted_at\x18\x02 \x01(\x04R\n" +
+	"executedAt\x12\x1b\n" +
+	"\tamount_in\x18\x03 \x01(\tR\bamountIn\x12\x1d\n" +
+	"\n" +
+	"quoted_out\x18\x04 \x01(\tR\tquotedOut\x12(\n" +
+	"\x10price_impact_bps\x18\x05 \x01(\tR\x0epriceImpactBps\x12)\n" +
+	"\x10transaction_hash\x18\x06 \x01(\tR\x0ftransactionHash\x124\n" +
+	"\x06status\x18\a \x01(\x0e2\x1c.quoteswap.TransactionStatusR\x06status\x12#\n" +
+	"\x04fill\x18\b \x01(\v2\x0f.quoteswap.FillR\x04fill\x12&\n" +
+	"\x05error\x18\t \x01(\v2\x10.quoteswap.ErrorR\x05error\x12\x10\n" +
+	"\x03dex\x18\n" +
+	" \x01(\tR\x03dex\"\xf8\x01\n" +
+	"\x16PlaceLimitOrderRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x02 \x01(\tR\x03dex\x12\x19\n" +
+	"\btoken_in\x18\x03 \x01(\tR\atokenIn\x12\x1b\n" +
+	"\ttoken_out\x18\x04 \x01(\tR\btokenOut\x12\x1b\n" +
+	"\tamount_in\x18\x05 \x01(\tR\bamountIn\x12\x1f\n" +
+	"\vlimit_price\x18\x06 \x01(\tR\n" +
+	"limitPrice\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\x04R\texpiresAt\x12!\n" +
+	"\fslippage_bps\x18\b \x01(\rR\vslippageBps\"#\n" +
+	"\x11LimitOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9d\x04\n" +
+	"\n" +
+	"LimitOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.quoteswap.OrderStatusR\x06status\x12\x14\n" +
+	"\x05chain\x18\x03 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x04 \x01(\tR\x03dex\x12\x19\n" +
+	"\btoken_in\x18\x05 \x01(\tR\atokenIn\x12\x1b\n" +
+	"\ttoken_out\x18\x06 \x01(\tR\btokenOut\x12\x1b\n" +
+	"\tamount_in\x18\a \x01(\tR\bamountIn\x12\x1f\n" +
+	"\vlimit_price\x18\b \x01(\tR\n" +
+	"limitPrice\x12$\n" +
+	"\x0emin_amount_out\x18\t \x01(\tR\fminAmountOut\x12!\n" +
+	"\fslippage_bps\x18\n" +
+	" \x01(\rR\vslippageBps\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x04R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\x04R\texpiresAt\x12#\n" +
+	"\rchecked_block\x18\r \x01(\x04R\fcheckedBlock\x12&\n" +
+	"\x0flast_quoted_out\x18\x0e \x01(\tR\rlastQuotedOut\x125\n" +
+	"\n" +
+	"executions\x18\x0f \x03(\v2\x15.quoteswap.OrderSliceR\n" +
+	"executions\x12&\n" +
+	"\x05error\x18\x10 \x01(\v2\x10.quoteswap.ErrorR\x05error\"\xd6\x02\n" +
+	"\x14CreateTriggerRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x02 \x01(\tR\x03dex\x12\x19\n" +
+	"\btoken_in\x18\x03 \x01(\tR\atokenIn\x12\x1b\n" +
+	"\ttoken_out\x18\x04 \x01(\tR\btokenOut\x12\x1b\n" +
+	"\tamount_in\x18\x05 \x01(\tR\bamountIn\x12*\n" +
+	"\x04kind\x18\x06 \x01(\x0e2\x16.quoteswap.TriggerKindR\x04kind\x12#\n" +
+	"\rtrigger_price\x18\a \x01(\tR\ftriggerPrice\x12.\n" +
+	"\x13twap_window_seconds\x18\b \x01(\rR\x11twapWindowSeconds\x12!\n" +
+	"\fslippage_bps\x18\t \x01(\rR\vslippageBps\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\x04R\texpiresAt\" \n" +
+	"\x0eTriggerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf6\x04\n" +
+	"\aTrigger\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.quoteswap.OrderStatusR\x06status\x12*\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x16.quoteswap.TriggerKindR\x04kind\x12\x14\n" +
+	"\x05chain\x18\x04 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x05 \x01(\tR\x03dex\x12\x19\n" +
+	"\btoken_in\x18\x06 \x01(\tR\atokenIn\x12\x1b\n" +
+	"\ttoken_out\x18\a \x01(\tR\btokenOut\x12\x1b\n" +
+	"\tamount_in\x18\b \x01(\tR\bamountIn\x12#\n" +
+	"\rtrigger_price\x18\t \x01(\tR\ftriggerPrice\x12.\n" +
+	"\x13twap_window_seconds\x18\n" +
+	" \x01(\rR\x11twapWindowSeconds\x12!\n" +
+	"\fslippage_bps\x18\v \x01(\rR\vslippageBps\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x04R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\x04R\texpiresAt\x12 \n" +
+	"\vevaluations\x18\x0e \x01(\x04R\vevaluations\x12K\n" +
+	"\x12recent_evaluations\x18\x0f \x03(\v2\x1c.quoteswap.TriggerEvaluationR\x11recentEvaluations\x125\n" +
+	"\n" +
+	"executions\x18\x10 \x03(\v2\x15.quoteswap.OrderSliceR\n" +
+	"executions\x12&\n" +
+	"\x05error\x18\x11 \x01(\v2\x10.quoteswap.ErrorR\x05error\"\xaf\x02\n" +
+	"\x11TriggerEvaluation\x12\x1d\n" +
+	"\n" +
+	"trigger_id\x18\x01 \x01(\tR\ttriggerId\x12!\n" +
+	"\fblock_number\x18\x02 \x01(\x04R\vblockNumber\x12'\n" +
+	"\x0fblock_timestamp\x18\x03 \x01(\x04R\x0eblockTimestamp\x12!\n" +
+	"\fevaluated_at\x18\x04 \x01(\x04R\vevaluatedAt\x12\x1d\n" +
+	"\n" +
+	"quoted_out\x18\x05 \x01(\tR\tquotedOut\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\tR\x05price\x12\x1d\n" +
+	"\n" +
+	"twap_price\x18\a \x01(\tR\ttwapPrice\x12\x10\n" +
+	"\x03met\x18\b \x01(\bR\x03met\x12&\n" +
+	"\x05error\x18\t \x01(\v2\x10.quoteswap.ErrorR\x05error\"\x88\x02\n" +
+	"\x14CreateDcaPlanRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x02 \x01(\tR\x03dex\x12\x19\n" +
+	"\btoken_in\x18\x03 \x01(\tR\atokenIn\x12\x1b\n" +
+	"\ttoken_out\x18\x04 \x01(\tR\btokenOut\x12\x1b\n" +
+	"\tamount_in\x18\x05 \x01(\tR\bamountIn\x12\x1a\n" +
+	"\bschedule\x18\x06 \x01(\tR\bschedule\x12!\n" +
+	"\fslippage_bps\x18\a \x01(\rR\vslippageBps\x12\x1b\n" +
+	"\tmax_price\x18\b \x01(\tR\bmaxPrice\x12\x17\n" +
+	"\aends_at\x18\t \x01(\x04R\x06endsAt\" \n" +
+	"\x0eDcaPlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
+	"\x13ListDcaPlansRequest\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12!\n" +
+	"\finclude_done\x18\x02 \x01(\bR\vincludeDone\"@\n" +
+	"\x14ListDcaPlansResponse\x12(\n" +
+	"\x05plans\x18\x01 \x03(\v2\x12.quoteswap.DcaPlanR\x05plans\"\xfb\x03\n" +
+	"\aDcaPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.quoteswap.OrderStatusR\x06status\x12\x14\n" +
+	"\x05chain\x18\x03 \x01(\tR\x05chain\x12\x10\n" +
+	"\x03dex\x18\x04 \x01(\tR\x03dex\x12\x19\n" +
+	"\btoken_in\x18\x05 \x01(\tR\atokenIn\x12\x1b\n" +
+	"\ttoken_out\x18\x06 \x01(\tR\btokenOut\x12\x1b\n" +
+	"\tamount_in\x18\a \x01(\tR\bamountIn\x12\x1a\n" +
+	"\bschedule\x18\b \x01(\tR\bschedule\x12!\n" +
+	"\fslippage_bps\x18\t \x01(\rR\vslippageBps\x12\x1b\n" +
+	"\tmax_price\x18\n" +
+	" \x01(\tR\bmaxPrice\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x04R\tcreatedAt\x12\x17\n" +
+	"\aends_at\x18\f \x01(\x04R\x06endsAt\x12\x1e\n" +
+	"\vnext_run_at\x18\r \x01(\x04R\tnextRunAt\x12\x14\n" +
+	"\x05spent\x18\x0e \x01(\tR\x05spent\x12\x16\n" +
+	"\x06bought\x18\x0f \x01(\tR\x06bought\x12)\n" +
+	"\x04runs\x18\x10 \x03(\v2\x15.quoteswap.OrderSliceR\x04runs\x12&\n" +
+	"\x05error\x18\x11 \x01(\v2\x10.quoteswap.ErrorR\x05error*F\n" +
 	"\x11TransactionStatus\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\v\n" +
 	"\aSUCCESS\x10\x01\x12\n" +
 	"\n" +
 	"\x06FAILED\x10\x02\x12\v\n" +
-	"\aPENDING\x10\x032\xa1\x01\n" +
+	"\aPENDING\x10\x03*\x81\x01\n" +
+	"\vOrderStatus\x12\x11\n" +
+	"\rORDER_UNKNOWN\x10\x00\x12\x10\n" +
+	"\fORDER_ACTIVE\x10\x01\x12\x13\n" +
+	"\x0fORDER_COMPLETED\x10\x02\x12\x13\n" +
+	"\x0fORDER_CANCELLED\x10\x03\x12\x11\n" +
+	"\rORDER_EXPIRED\x10\x04\x12\x10\n" +
+	"\fORDER_FAILED\x10\x05*B\n" +
+	"\vTriggerKind\x12\x13\n" +
+	"\x0fTRIGGER_UNKNOWN\x10\x00\x12\r\n" +
+	"\tSTOP_LOSS\x10\x01\x12\x0f\n" +
+	"\vTAKE_PROFIT\x10\x022\xfd\r\n" +
 	"\x10QuoteSwapService\x12C\n" +
 	"\bGetQuote\x12\x1a.quoteswap.GetQuoteRequest\x1a\x1b.quoteswap.GetQuoteResponse\x12H\n" +
-	"\vExecuteSwap\x12\x1b.quoteswap.ExecuteTxRequest\x1a\x1c.quoteswap.ExecuteTxResponseB\x0eZ\fgo/quoteswapb\x06proto3"
+	"\vExecuteSwap\x12\x1b.quoteswap.ExecuteTxRequest\x1a\x1c.quoteswap.ExecuteTxResponse\x12R\n" +
+	"\rBatchGetQuote\x12\x1f.quoteswap.BatchGetQuoteRequest\x1a .quoteswap.BatchGetQuoteResponse\x128\n" +
+	"\bGetToken\x12\x1a.quoteswap.GetTokenRequest\x1a\x10.quoteswap.Token\x12L\n" +
+	"\vGetBalances\x12\x1d.quoteswap.GetBalancesRequest\x1a\x1e.quoteswap.GetBalancesResponse\x12I\n" +
+	"\n" +
+	"ListVenues\x12\x1c.quoteswap.ListVenuesRequest\x1a\x1d.quoteswap.ListVenuesResponse\x12Z\n" +
+	"\x13GetHistoricalQuotes\x12%.quoteswap.GetHistoricalQuotesRequest\x1a\x1a.quoteswap.HistoricalQuote0\x01\x12F\n" +
+	"\tListPools\x12\x1b.quoteswap.ListPoolsRequest\x1a\x1c.quoteswap.ListPoolsResponse\x12@\n" +
+	"\aGetTwap\x12\x19.quoteswap.GetTwapRequest\x1a\x1a.quoteswap.GetTwapResponse\x12C\n" +
+	"\bGetDepth\x12\x1a.quoteswap.GetDepthRequest\x1a\x1b.quoteswap.GetDepthResponse\x12Y\n" +
+	"\x14CreateScheduledOrder\x12&.quoteswap.CreateScheduledOrderRequest\x1a\x19.quoteswap.ScheduledOrder\x12S\n" +
+	"\x14CancelScheduledOrder\x12 .quoteswap.ScheduledOrderRequest\x1a\x19.quoteswap.ScheduledOrder\x12P\n" +
+	"\x11GetScheduledOrder\x12 .quoteswap.ScheduledOrderRequest\x1a\x19.quoteswap.ScheduledOrder\x12K\n" +
+	"\x0fPlaceLimitOrder\x12!.quoteswap.PlaceLimitOrderRequest\x1a\x15.quoteswap.LimitOrder\x12G\n" +
+	"\x10CancelLimitOrder\x12\x1c.quoteswap.LimitOrderRequest\x1a\x15.quoteswap.LimitOrder\x12D\n" +
+	"\rGetLimitOrder\x12\x1c.quoteswap.LimitOrderRequest\x1a\x15.quoteswap.LimitOrder\x12D\n" +
+	"\rCreateTrigger\x12\x1f.quoteswap.CreateTriggerRequest\x1a\x12.quoteswap.Trigger\x12>\n" +
+	"\rCancelTrigger\x12\x19.quoteswap.TriggerRequest\x1a\x12.quoteswap.Trigger\x12;\n" +
+	"\n" +
+	"GetTrigger\x12\x19.quoteswap.TriggerRequest\x1a\x12.quoteswap.Trigger\x12S\n" +
+	"\x16ListTriggerEvaluations\x12\x19.quoteswap.TriggerRequest\x1a\x1c.quoteswap.TriggerEvaluation0\x01\x12D\n" +
+	"\rCreateDcaPlan\x12\x1f.quoteswap.CreateDcaPlanRequest\x1a\x12.quoteswap.DcaPlan\x12>\n" +
+	"\rCancelDcaPlan\x12\x19.quoteswap.DcaPlanRequest\x1a\x12.quoteswap.DcaPlan\x12;\n" +
+	"\n" +
+	"GetDcaPlan\x12\x19.quoteswap.DcaPlanRequest\x1a\x12.quoteswap.DcaPlan\x12O\n" +
+	"\fListDcaPlans\x12\x1e.quoteswap.ListDcaPlansRequest\x1a\x1f.quoteswap.ListDcaPlansResponseB\x0eZ\fgo/quoteswapb\x06proto3"
 
 var (
 	file_quoteswap_quoteswap_proto_rawDescOnce sync.Once
@@ -477,29 +4477,162 @@ func file_quoteswap_quoteswap_proto_rawDescGZIP() []byte {
 	return file_quoteswap_quoteswap_proto_rawDescData
 }
 
-var file_quoteswap_quoteswap_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_quoteswap_quoteswap_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_quoteswap_quoteswap_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_quoteswap_quoteswap_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_quoteswap_quoteswap_proto_goTypes = []any{
-	(TransactionStatus)(0),    // 0: quoteswap.TransactionStatus
-	(*GetQuoteRequest)(nil),   // 1: quoteswap.GetQuoteRequest
-	(*GetQuoteResponse)(nil),  // 2: quoteswap.GetQuoteResponse
-	(*ExecuteTxRequest)(nil),  // 3: quoteswap.ExecuteTxRequest
-	(*ExecuteTxResponse)(nil), // 4: quoteswap.ExecuteTxResponse
-	(*Error)(nil),             // 5: quoteswap.Error
+	(TransactionStatus)(0),              // 0: quoteswap.TransactionStatus
+	(OrderStatus)(0),                    // 1: quoteswap.OrderStatus
+	(TriggerKind)(0),                    // 2: quoteswap.TriggerKind
+	(*GetQuoteRequest)(nil),             // 3: quoteswap.GetQuoteRequest
+	(*GetQuoteResponse)(nil),            // 4: quoteswap.GetQuoteResponse
+	(*SplitLeg)(nil),                    // 5: quoteswap.SplitLeg
+	(*BatchGetQuoteRequest)(nil),        // 6: quoteswap.BatchGetQuoteRequest
+	(*BatchGetQuoteResponse)(nil),       // 7: quoteswap.BatchGetQuoteResponse
+	(*BatchQuoteResult)(nil),            // 8: quoteswap.BatchQuoteResult
+	(*GetTokenRequest)(nil),             // 9: quoteswap.GetTokenRequest
+	(*Token)(nil),                       // 10: quoteswap.Token
+	(*TokenRef)(nil),                    // 11: quoteswap.TokenRef
+	(*GetBalancesRequest)(nil),          // 12: quoteswap.GetBalancesRequest
+	(*GetBalancesResponse)(nil),         // 13: quoteswap.GetBalancesResponse
+	(*WalletBalances)(nil),              // 14: quoteswap.WalletBalances
+	(*Balance)(nil),                     // 15: quoteswap.Balance
+	(*ExecuteTxRequest)(nil),            // 16: quoteswap.ExecuteTxRequest
+	(*ExecuteTxResponse)(nil),           // 17: quoteswap.ExecuteTxResponse
+	(*Fill)(nil),                        // 18: quoteswap.Fill
+	(*ListVenuesRequest)(nil),           // 19: quoteswap.ListVenuesRequest
+	(*ListVenuesResponse)(nil),          // 20: quoteswap.ListVenuesResponse
+	(*Venue)(nil),                       // 21: quoteswap.Venue
+	(*GetHistoricalQuotesRequest)(nil),  // 22: quoteswap.GetHistoricalQuotesRequest
+	(*HistoricalQuote)(nil),             // 23: quoteswap.HistoricalQuote
+	(*ListPoolsRequest)(nil),            // 24: quoteswap.ListPoolsRequest
+	(*ListPoolsResponse)(nil),           // 25: quoteswap.ListPoolsResponse
+	(*Pool)(nil),                        // 26: quoteswap.Pool
+	(*Error)(nil),                       // 27: quoteswap.Error
+	(*GetTwapRequest)(nil),              // 28: quoteswap.GetTwapRequest
+	(*GetTwapResponse)(nil),             // 29: quoteswap.GetTwapResponse
+	(*GetDepthRequest)(nil),             // 30: quoteswap.GetDepthRequest
+	(*GetDepthResponse)(nil),            // 31: quoteswap.GetDepthResponse
+	(*DepthLevel)(nil),                  // 32: quoteswap.DepthLevel
+	(*CreateScheduledOrderRequest)(nil), // 33: quoteswap.CreateScheduledOrderRequest
+	(*ScheduledOrderRequest)(nil),       // 34: quoteswap.ScheduledOrderRequest
+	(*ScheduledOrder)(nil),              // 35: quoteswap.ScheduledOrder
+	(*OrderSlice)(nil),                  // 36: quoteswap.OrderSlice
+	(*PlaceLimitOrderRequest)(nil),      // 37: quoteswap.PlaceLimitOrderRequest
+	(*LimitOrderRequest)(nil),           // 38: quoteswap.LimitOrderRequest
+	(*LimitOrder)(nil),                  // 39: quoteswap.LimitOrder
+	(*CreateTriggerRequest)(nil),        // 40: quoteswap.CreateTriggerRequest
+	(*TriggerRequest)(nil),              // 41: quoteswap.TriggerRequest
+	(*Trigger)(nil),                     // 42: quoteswap.Trigger
+	(*TriggerEvaluation)(nil),           // 43: quoteswap.TriggerEvaluation
+	(*CreateDcaPlanRequest)(nil),        // 44: quoteswap.CreateDcaPlanRequest
+	(*DcaPlanRequest)(nil),              // 45: quoteswap.DcaPlanRequest
+	(*ListDcaPlansRequest)(nil),         // 46: quoteswap.ListDcaPlansRequest
+	(*ListDcaPlansResponse)(nil),        // 47: quoteswap.ListDcaPlansResponse
+	(*DcaPlan)(nil),                     // 48: quoteswap.DcaPlan
+	nil,                                 // 49: quoteswap.GetBalancesRequest.QuoteTokensEntry
+	nil,                                 // 50: quoteswap.WalletBalances.TotalValueDecimalEntry
 }
 var file_quoteswap_quoteswap_proto_depIdxs = []int32{
-	2, // 0: quoteswap.ExecuteTxRequest.quoting_response:type_name -> quoteswap.GetQuoteResponse
-	0, // 1: quoteswap.ExecuteTxResponse.status:type_name -> quoteswap.TransactionStatus
-	5, // 2: quoteswap.ExecuteTxResponse.error:type_name -> quoteswap.Error
-	1, // 3: quoteswap.QuoteSwapService.GetQuote:input_type -> quoteswap.GetQuoteRequest
-	3, // 4: quoteswap.QuoteSwapService.ExecuteSwap:input_type -> quoteswap.ExecuteTxRequest
-	2, // 5: quoteswap.QuoteSwapService.GetQuote:output_type -> quoteswap.GetQuoteResponse
-	4, // 6: quoteswap.QuoteSwapService.ExecuteSwap:output_type -> quoteswap.ExecuteTxResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	26, // 0: quoteswap.GetQuoteResponse.pool:type_name -> quoteswap.Pool
+	5,  // 1: quoteswap.GetQuoteResponse.splits:type_name -> quoteswap.SplitLeg
+	26, // 2: quoteswap.SplitLeg.pool:type_name -> quoteswap.Pool
+	3,  // 3: quoteswap.BatchGetQuoteRequest.quotes:type_name -> quoteswap.GetQuoteRequest
+	8,  // 4: quoteswap.BatchGetQuoteResponse.results:type_name -> quoteswap.BatchQuoteResult
+	4,  // 5: quoteswap.BatchQuoteResult.quote:type_name -> quoteswap.GetQuoteResponse
+	27, // 6: quoteswap.BatchQuoteResult.error:type_name -> quoteswap.Error
+	11, // 7: quoteswap.GetBalancesRequest.tokens:type_name -> quoteswap.TokenRef
+	49, // 8: quoteswap.GetBalancesRequest.quote_tokens:type_name -> quoteswap.GetBalancesRequest.QuoteTokensEntry
+	14, // 9: quoteswap.GetBalancesResponse.wallets:type_name -> quoteswap.WalletBalances
+	15, // 10: quoteswap.WalletBalances.balances:type_name -> quoteswap.Balance
+	50, // 11: quoteswap.WalletBalances.total_value_decimal:type_name -> quoteswap.WalletBalances.TotalValueDecimalEntry
+	27, // 12: quoteswap.Balance.error:type_name -> quoteswap.Error
+	4,  // 13: quoteswap.ExecuteTxRequest.quoting_response:type_name -> quoteswap.GetQuoteResponse
+	0,  // 14: quoteswap.ExecuteTxResponse.status:type_name -> quoteswap.TransactionStatus
+	27, // 15: quoteswap.ExecuteTxResponse.error:type_name -> quoteswap.Error
+	18, // 16: quoteswap.ExecuteTxResponse.fill:type_name -> quoteswap.Fill
+	21, // 17: quoteswap.ListVenuesResponse.venues:type_name -> quoteswap.Venue
+	27, // 18: quoteswap.Venue.error:type_name -> quoteswap.Error
+	3,  // 19: quoteswap.GetHistoricalQuotesRequest.quote:type_name -> quoteswap.GetQuoteRequest
+	27, // 20: quoteswap.HistoricalQuote.error:type_name -> quoteswap.Error
+	26, // 21: quoteswap.ListPoolsResponse.pools:type_name -> quoteswap.Pool
+	26, // 22: quoteswap.GetTwapResponse.pool:type_name -> quoteswap.Pool
+	26, // 23: quoteswap.GetDepthResponse.pool:type_name -> quoteswap.Pool
+	32, // 24: quoteswap.GetDepthResponse.levels:type_name -> quoteswap.DepthLevel
+	4,  // 25: quoteswap.DepthLevel.quote:type_name -> quoteswap.GetQuoteResponse
+	27, // 26: quoteswap.DepthLevel.error:type_name -> quoteswap.Error
+	1,  // 27: quoteswap.ScheduledOrder.status:type_name -> quoteswap.OrderStatus
+	36, // 28: quoteswap.ScheduledOrder.fills:type_name -> quoteswap.OrderSlice
+	27, // 29: quoteswap.ScheduledOrder.error:type_name -> quoteswap.Error
+	0,  // 30: quoteswap.OrderSlice.status:type_name -> quoteswap.TransactionStatus
+	18, // 31: quoteswap.OrderSlice.fill:type_name -> quoteswap.Fill
+	27, // 32: quoteswap.OrderSlice.error:type_name -> quoteswap.Error
+	1,  // 33: quoteswap.LimitOrder.status:type_name -> quoteswap.OrderStatus
+	36, // 34: quoteswap.LimitOrder.executions:type_name -> quoteswap.OrderSlice
+	27, // 35: quoteswap.LimitOrder.error:type_name -> quoteswap.Error
+	2,  // 36: quoteswap.CreateTriggerRequest.kind:type_name -> quoteswap.TriggerKind
+	1,  // 37: quoteswap.Trigger.status:type_name -> quoteswap.OrderStatus
+	2,  // 38: quoteswap.Trigger.kind:type_name -> quoteswap.TriggerKind
+	43, // 39: quoteswap.Trigger.recent_evaluations:type_name -> quoteswap.TriggerEvaluation
+	36, // 40: quoteswap.Trigger.executions:type_name -> quoteswap.OrderSlice
+	27, // 41: quoteswap.Trigger.error:type_name -> quoteswap.Error
+	27, // 42: quoteswap.TriggerEvaluation.error:type_name -> quoteswap.Error
+	48, // 43: quoteswap.ListDcaPlansResponse.plans:type_name -> quoteswap.DcaPlan
+	1,  // 44: quoteswap.DcaPlan.status:type_name -> quoteswap.OrderStatus
+	36, // 45: quoteswap.DcaPlan.runs:type_name -> quoteswap.OrderSlice
+	27, // 46: quoteswap.DcaPlan.error:type_name -> quoteswap.Error
+	3,  // 47: quoteswap.QuoteSwapService.GetQuote:input_type -> quoteswap.GetQuoteRequest
+	16, // 48: quoteswap.QuoteSwapService.ExecuteSwap:input_type -> quoteswap.ExecuteTxRequest
+	6,  // 49: quoteswap.QuoteSwapService.BatchGetQuote:input_type -> quoteswap.BatchGetQuoteRequest
+	9,  // 50: quoteswap.QuoteSwapService.GetToken:input_type -> quoteswap.GetTokenRequest
+	12, // 51: quoteswap.QuoteSwapService.GetBalances:input_type -> quoteswap.GetBalancesRequest
+	19, // 52: quoteswap.QuoteSwapService.ListVenues:input_type -> quoteswap.ListVenuesRequest
+	22, // 53: quoteswap.QuoteSwapService.GetHistoricalQuotes:input_type -> quoteswap.GetHistoricalQuotesRequest
+	24, // 54: quoteswap.QuoteSwapService.ListPools:input_type -> quoteswap.ListPoolsRequest
+	28, // 55: quoteswap.QuoteSwapService.GetTwap:input_type -> quoteswap.GetTwapRequest
+	30, // 56: quoteswap.QuoteSwapService.GetDepth:input_type -> quoteswap.GetDepthRequest
+	33, // 57: quoteswap.QuoteSwapService.CreateScheduledOrder:input_type -> quoteswap.CreateScheduledOrderRequest
+	34, // 58: quoteswap.QuoteSwapService.CancelScheduledOrder:input_type -> quoteswap.ScheduledOrderRequest
+	34, // 59: quoteswap.QuoteSwapService.GetScheduledOrder:input_type -> quoteswap.ScheduledOrderRequest
+	37, // 60: quoteswap.QuoteSwapService.PlaceLimitOrder:input_type -> quoteswap.PlaceLimitOrderRequest
+	38, // 61: quoteswap.QuoteSwapService.CancelLimitOrder:input_type -> quoteswap.LimitOrderRequest
+	38, // 62: quoteswap.QuoteSwapService.GetLimitOrder:input_type -> quoteswap.LimitOrderRequest
+	40, // 63: quoteswap.QuoteSwapService.CreateTrigger:input_type -> quoteswap.CreateTriggerRequest
+	41, // 64: quoteswap.QuoteSwapService.CancelTrigger:input_type -> quoteswap.TriggerRequest
+	41, // 65: quoteswap.QuoteSwapService.GetTrigger:input_type -> quoteswap.TriggerRequest
+	41, // 66: quoteswap.QuoteSwapService.ListTriggerEvaluations:input_type -> quoteswap.TriggerRequest
+	44, // 67: quoteswap.QuoteSwapService.CreateDcaPlan:input_type -> quoteswap.CreateDcaPlanRequest
+	45, // 68: quoteswap.QuoteSwapService.CancelDcaPlan:input_type -> quoteswap.DcaPlanRequest
+	45, // 69: quoteswap.QuoteSwapService.GetDcaPlan:input_type -> quoteswap.DcaPlanRequest
+	46, // 70: quoteswap.QuoteSwapService.ListDcaPlans:input_type -> quoteswap.ListDcaPlansRequest
+	4,  // 71: quoteswap.QuoteSwapService.GetQuote:output_type -> quoteswap.GetQuoteResponse
+	17, // 72: quoteswap.QuoteSwapService.ExecuteSwap:output_type -> quoteswap.ExecuteTxResponse
+	7,  // 73: quoteswap.QuoteSwapService.BatchGetQuote:output_type -> quoteswap.BatchGetQuoteResponse
+	10, // 74: quoteswap.QuoteSwapService.GetToken:output_type -> quoteswap.Token
+	13, // 75: quoteswap.QuoteSwapService.GetBalances:output_type -> quoteswap.GetBalancesResponse
+	20, // 76: quoteswap.QuoteSwapService.ListVenues:output_type -> quoteswap.ListVenuesResponse
+	23, // 77: quoteswap.QuoteSwapService.GetHistoricalQuotes:output_type -> quoteswap.HistoricalQuote
+	25, // 78: quoteswap.QuoteSwapService.ListPools:output_type -> quoteswap.ListPoolsResponse
+	29, // 79: quoteswap.QuoteSwapService.GetTwap:output_type -> quoteswap.GetTwapResponse
+	31, // 80: quoteswap.QuoteSwapService.GetDepth:output_type -> quoteswap.GetDepthResponse
+	35, // 81: quoteswap.QuoteSwapService.CreateScheduledOrder:output_type -> quoteswap.ScheduledOrder
+	35, // 82: quoteswap.QuoteSwapService.CancelScheduledOrder:output_type -> quoteswap.ScheduledOrder
+	35, // 83: quoteswap.QuoteSwapService.GetScheduledOrder:output_type -> quoteswap.ScheduledOrder
+	39, // 84: quoteswap.QuoteSwapService.PlaceLimitOrder:output_type -> quoteswap.LimitOrder
+	39, // 85: quoteswap.QuoteSwapService.CancelLimitOrder:output_type -> quoteswap.LimitOrder
+	39, // 86: quoteswap.QuoteSwapService.GetLimitOrder:output_type -> quoteswap.LimitOrder
+	42, // 87: quoteswap.QuoteSwapService.CreateTrigger:output_type -> quoteswap.Trigger
+	42, // 88: quoteswap.QuoteSwapService.CancelTrigger:output_type -> quoteswap.Trigger
+	42, // 89: quoteswap.QuoteSwapService.GetTrigger:output_type -> quoteswap.Trigger
+	43, // 90: quoteswap.QuoteSwapService.ListTriggerEvaluations:output_type -> quoteswap.TriggerEvaluation
+	48, // 91: quoteswap.QuoteSwapService.CreateDcaPlan:output_type -> quoteswap.DcaPlan
+	48, // 92: quoteswap.QuoteSwapService.CancelDcaPlan:output_type -> quoteswap.DcaPlan
+	48, // 93: quoteswap.QuoteSwapService.GetDcaPlan:output_type -> quoteswap.DcaPlan
+	47, // 94: quoteswap.QuoteSwapService.ListDcaPlans:output_type -> quoteswap.ListDcaPlansResponse
+	71, // [71:95] is the sub-list for method output_type
+	47, // [47:71] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_quoteswap_quoteswap_proto_init() }
@@ -512,8 +4645,8 @@ func file_quoteswap_quoteswap_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quoteswap_quoteswap_proto_rawDesc), len(file_quoteswap_quoteswap_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      3,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,8 +20,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	QuoteSwapService_GetQuote_FullMethodName    = "/quoteswap.QuoteSwapService/GetQuote"
-	QuoteSwapService_ExecuteSwap_FullMethodName = "/quoteswap.QuoteSwapService/ExecuteSwap"
+	QuoteSwapService_GetQuote_FullMethodName               = "/quoteswap.QuoteSwapService/GetQuote"
+	QuoteSwapService_ExecuteSwap_FullMethodName            = "/quoteswap.QuoteSwapService/ExecuteSwap"
+	QuoteSwapService_BatchGetQuote_FullMethodName          = "/quoteswap.QuoteSwapService/BatchGetQuote"
+	QuoteSwapService_GetToken_FullMethodName               = "/quoteswap.QuoteSwapService/GetToken"
+	QuoteSwapService_GetBalances_FullMethodName            = "/quoteswap.QuoteSwapService/GetBalances"
+	QuoteSwapService_ListVenues_FullMethodName             = "/quoteswap.QuoteSwapService/ListVenues"
+	QuoteSwapService_GetHistoricalQuotes_FullMethodName    = "/quoteswap.QuoteSwapService/GetHistoricalQuotes"
+	QuoteSwapService_ListPools_FullMethodName              = "/quoteswap.QuoteSwapService/ListPools"
+	QuoteSwapService_GetTwap_FullMethodName                = "/quoteswap.QuoteSwapService/GetTwap"
+	QuoteSwapService_GetDepth_FullMethodName               = "/quoteswap.QuoteSwapService/GetDepth"
+	QuoteSwapService_CreateScheduledOrder_FullMethodName   = "/quoteswap.QuoteSwapService/CreateScheduledOrder"
+	QuoteSwapService_CancelScheduledOrder_FullMethodName   = "/quoteswap.QuoteSwapService/CancelScheduledOrder"
+	QuoteSwapService_GetScheduledOrder_FullMethodName      = "/quoteswap.QuoteSwapService/GetScheduledOrder"
+	QuoteSwapService_PlaceLimitOrder_FullMethodName        = "/quoteswap.QuoteSwapService/PlaceLimitOrder"
+	QuoteSwapService_CancelLimitOrder_FullMethodName       = "/quoteswap.QuoteSwapService/CancelLimitOrder"
+	QuoteSwapService_GetLimitOrder_FullMethodName          = "/quoteswap.QuoteSwapService/GetLimitOrder"
+	QuoteSwapService_CreateTrigger_FullMethodName          = "/quoteswap.QuoteSwapService/CreateTrigger"
+	QuoteSwapService_CancelTrigger_FullMethodName          = "/quoteswap.QuoteSwapService/CancelTrigger"
+	QuoteSwapService_GetTrigger_FullMethodName             = "/quoteswap.QuoteSwapService/GetTrigger"
+	QuoteSwapService_ListTriggerEvaluations_FullMethodName = "/quoteswap.QuoteSwapService/ListTriggerEvaluations"
+	QuoteSwapService_CreateDcaPlan_FullMethodName          = "/quoteswap.QuoteSwapService/CreateDcaPlan"
+	QuoteSwapService_CancelDcaPlan_FullMethodName          = "/quoteswap.QuoteSwapService/CancelDcaPlan"
+	QuoteSwapService_GetDcaPlan_FullMethodName             = "/quoteswap.QuoteSwapService/GetDcaPlan"
+	QuoteSwapService_ListDcaPlans_FullMethodName           = "/quoteswap.QuoteSwapService/ListDcaPlans"
 )
 
 // QuoteSwapServiceClient is the client API for QuoteSwapService service.
@@ -30,6 +52,28 @@ const (
 type QuoteSwapServiceClient interface {
 	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
 	ExecuteSwap(ctx context.Context, in *ExecuteTxRequest, opts ...grpc.CallOption) (*ExecuteTxResponse, error)
+	BatchGetQuote(ctx context.Context, in *BatchGetQuoteRequest, opts ...grpc.CallOption) (*BatchGetQuoteResponse, error)
+	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*Token, error)
+	GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error)
+	ListVenues(ctx context.Context, in *ListVenuesRequest, opts ...grpc.CallOption) (*ListVenuesResponse, error)
+	GetHistoricalQuotes(ctx context.Context, in *GetHistoricalQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HistoricalQuote], error)
+	ListPools(ctx context.Context, in *ListPoolsRequest, opts ...grpc.CallOption) (*ListPoolsResponse, error)
+	GetTwap(ctx context.Context, in *GetTwapRequest, opts ...grpc.CallOption) (*GetTwapResponse, error)
+	GetDepth(ctx context.Context, in *GetDepthRequest, opts ...grpc.CallOption) (*GetDepthResponse, error)
+	CreateScheduledOrder(ctx context.Context, in *CreateScheduledOrderRequest, opts ...grpc.CallOption) (*ScheduledOrder, error)
+	CancelScheduledOrder(ctx context.Context, in *ScheduledOrderRequest, opts ...grpc.CallOption) (*ScheduledOrder, error)
+	GetScheduledOrder(ctx context.Context, in *ScheduledOrderRequest, opts ...grpc.CallOption) (*ScheduledOrder, error)
+	PlaceLimitOrder(ctx context.Context, in *PlaceLimitOrderRequest, opts ...grpc.CallOption) (*LimitOrder, error)
+	CancelLimitOrder(ctx context.Context, in *LimitOrderRequest, opts ...grpc.CallOption) (*LimitOrder, error)
+	GetLimitOrder(ctx context.Context, in *LimitOrderRequest, opts ...grpc.CallOption) (*LimitOrder, error)
+	CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*Trigger, error)
+	CancelTrigger(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*Trigger, error)
+	GetTrigger(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*Trigger, error)
+	ListTriggerEvaluations(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TriggerEvaluation], error)
+	CreateDcaPlan(ctx context.Context, in *CreateDcaPlanRequest, opts ...grpc.CallOption) (*DcaPlan, error)
+	CancelDcaPlan(ctx context.Context, in *DcaPlanRequest, opts ...grpc.CallOption) (*DcaPlan, error)
+	GetDcaPlan(ctx context.Context, in *DcaPlanRequest, opts ...grpc.CallOption) (*DcaPlan, error)
+	ListDcaPlans(ctx context.Context, in *ListDcaPlansRequest, opts ...grpc.CallOption) (*ListDcaPlansResponse, error)
 }
 
 type quoteSwapServiceClient struct {
@@ -60,12 +104,272 @@ func (c *quoteSwapServiceClient) ExecuteSwap(ctx context.Context, in *ExecuteTxR
 	return out, nil
 }
 
+func (c *quoteSwapServiceClient) BatchGetQuote(ctx context.Context, in *BatchGetQuoteRequest, opts ...grpc.CallOption) (*BatchGetQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetQuoteResponse)
+	err := c.cc.Invoke(ctx, QuoteSwapService_BatchGetQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*Token, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Token)
+	err := c.cc.Invoke(ctx, QuoteSwapService_GetToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalancesResponse)
+	err := c.cc.Invoke(ctx, QuoteSwapService_GetBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) ListVenues(ctx context.Context, in *ListVenuesRequest, opts ...grpc.CallOption) (*ListVenuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVenuesResponse)
+	err := c.cc.Invoke(ctx, QuoteSwapService_ListVenues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) GetHistoricalQuotes(ctx context.Context, in *GetHistoricalQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HistoricalQuote], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuoteSwapService_ServiceDesc.Streams[0], QuoteSwapService_GetHistoricalQuotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetHistoricalQuotesRequest, HistoricalQuote]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuoteSwapService_GetHistoricalQuotesClient = grpc.ServerStreamingClient[HistoricalQuote]
+
+func (c *quoteSwapServiceClient) ListPools(ctx context.Context, in *ListPoolsRequest, opts ...grpc.CallOption) (*ListPoolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPoolsResponse)
+	err := c.cc.Invoke(ctx, QuoteSwapService_ListPools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) GetTwap(ctx context.Context, in *GetTwapRequest, opts ...grpc.CallOption) (*GetTwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTwapResponse)
+	err := c.cc.Invoke(ctx, QuoteSwapService_GetTwap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) GetDepth(ctx context.Context, in *GetDepthRequest, opts ...grpc.CallOption) (*GetDepthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDepthResponse)
+	err := c.cc.Invoke(ctx, QuoteSwapService_GetDepth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) CreateScheduledOrder(ctx context.Context, in *CreateScheduledOrderRequest, opts ...grpc.CallOption) (*ScheduledOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledOrder)
+	err := c.cc.Invoke(ctx, QuoteSwapService_CreateScheduledOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) CancelScheduledOrder(ctx context.Context, in *ScheduledOrderRequest, opts ...grpc.CallOption) (*ScheduledOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledOrder)
+	err := c.cc.Invoke(ctx, QuoteSwapService_CancelScheduledOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) GetScheduledOrder(ctx context.Context, in *ScheduledOrderRequest, opts ...grpc.CallOption) (*ScheduledOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledOrder)
+	err := c.cc.Invoke(ctx, QuoteSwapService_GetScheduledOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) PlaceLimitOrder(ctx context.Context, in *PlaceLimitOrderRequest, opts ...grpc.CallOption) (*LimitOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LimitOrder)
+	err := c.cc.Invoke(ctx, QuoteSwapService_PlaceLimitOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) CancelLimitOrder(ctx context.Context, in *LimitOrderRequest, opts ...grpc.CallOption) (*LimitOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LimitOrder)
+	err := c.cc.Invoke(ctx, QuoteSwapService_CancelLimitOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) GetLimitOrder(ctx context.Context, in *LimitOrderRequest, opts ...grpc.CallOption) (*LimitOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LimitOrder)
+	err := c.cc.Invoke(ctx, QuoteSwapService_GetLimitOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*Trigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trigger)
+	err := c.cc.Invoke(ctx, QuoteSwapService_CreateTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) CancelTrigger(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*Trigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trigger)
+	err := c.cc.Invoke(ctx, QuoteSwapService_CancelTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) GetTrigger(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*Trigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trigger)
+	err := c.cc.Invoke(ctx, QuoteSwapService_GetTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) ListTriggerEvaluations(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TriggerEvaluation], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuoteSwapService_ServiceDesc.Streams[1], QuoteSwapService_ListTriggerEvaluations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TriggerRequest, TriggerEvaluation]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuoteSwapService_ListTriggerEvaluationsClient = grpc.ServerStreamingClient[TriggerEvaluation]
+
+func (c *quoteSwapServiceClient) CreateDcaPlan(ctx context.Context, in *CreateDcaPlanRequest, opts ...grpc.CallOption) (*DcaPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DcaPlan)
+	err := c.cc.Invoke(ctx, QuoteSwapService_CreateDcaPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) CancelDcaPlan(ctx context.Context, in *DcaPlanRequest, opts ...grpc.CallOption) (*DcaPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DcaPlan)
+	err := c.cc.Invoke(ctx, QuoteSwapService_CancelDcaPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) GetDcaPlan(ctx context.Context, in *DcaPlanRequest, opts ...grpc.CallOption) (*DcaPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DcaPlan)
+	err := c.cc.Invoke(ctx, QuoteSwapService_GetDcaPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quoteSwapServiceClient) ListDcaPlans(ctx context.Context, in *ListDcaPlansRequest, opts ...grpc.CallOption) (*ListDcaPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDcaPlansResponse)
+	err := c.cc.Invoke(ctx, QuoteSwapService_ListDcaPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuoteSwapServiceServer is the server API for QuoteSwapService service.
 // All implementations must embed UnimplementedQuoteSwapServiceServer
 // for forward compatibility.
 type QuoteSwapServiceServer interface {
 	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
 	ExecuteSwap(context.Context, *ExecuteTxRequest) (*ExecuteTxResponse, error)
+	BatchGetQuote(context.Context, *BatchGetQuoteRequest) (*BatchGetQuoteResponse, error)
+	GetToken(context.Context, *GetTokenRequest) (*Token, error)
+	GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error)
+	ListVenues(context.Context, *ListVenuesRequest) (*ListVenuesResponse, error)
+	GetHistoricalQuotes(*GetHistoricalQuotesRequest, grpc.ServerStreamingServer[HistoricalQuote]) error
+	ListPools(context.Context, *ListPoolsRequest) (*ListPoolsResponse, error)
+	GetTwap(context.Context, *GetTwapRequest) (*GetTwapResponse, error)
+	GetDepth(context.Context, *GetDepthRequest) (*GetDepthResponse, error)
+	CreateScheduledOrder(context.Context, *CreateScheduledOrderRequest) (*ScheduledOrder, error)
+	CancelScheduledOrder(context.Context, *ScheduledOrderRequest) (*ScheduledOrder, error)
+	GetScheduledOrder(context.Context, *ScheduledOrderRequest) (*ScheduledOrder, error)
+	PlaceLimitOrder(context.Context, *PlaceLimitOrderRequest) (*LimitOrder, error)
+	CancelLimitOrder(context.Context, *LimitOrderRequest) (*LimitOrder, error)
+	GetLimitOrder(context.Context, *LimitOrderRequest) (*LimitOrder, error)
+	CreateTrigger(context.Context, *CreateTriggerRequest) (*Trigger, error)
+	CancelTrigger(context.Context, *TriggerRequest) (*Trigger, error)
+	GetTrigger(context.Context, *TriggerRequest) (*Trigger, error)
+	ListTriggerEvaluations(*TriggerRequest, grpc.ServerStreamingServer[TriggerEvaluation]) error
+	CreateDcaPlan(context.Context, *CreateDcaPlanRequest) (*DcaPlan, error)
+	CancelDcaPlan(context.Context, *DcaPlanRequest) (*DcaPlan, error)
+	GetDcaPlan(context.Context, *DcaPlanRequest) (*DcaPlan, error)
+	ListDcaPlans(context.Context, *ListDcaPlansRequest) (*ListDcaPlansResponse, error)
 	mustEmbedUnimplementedQuoteSwapServiceServer()
 }
 
@@ -82,6 +386,72 @@ func (UnimplementedQuoteSwapServiceServer) GetQuote(context.Context, *GetQuoteRe
 func (UnimplementedQuoteSwapServiceServer) ExecuteSwap(context.Context, *ExecuteTxRequest) (*ExecuteTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSwap not implemented")
 }
+func (UnimplementedQuoteSwapServiceServer) BatchGetQuote(context.Context, *BatchGetQuoteRequest) (*BatchGetQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetQuote not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) GetToken(context.Context, *GetTokenRequest) (*Token, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalances not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) ListVenues(context.Context, *ListVenuesRequest) (*ListVenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVenues not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) GetHistoricalQuotes(*GetHistoricalQuotesRequest, grpc.ServerStreamingServer[HistoricalQuote]) error {
+	return status.Errorf(codes.Unimplemented, "method GetHistoricalQuotes not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) ListPools(context.Context, *ListPoolsRequest) (*ListPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPools not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) GetTwap(context.Context, *GetTwapRequest) (*GetTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwap not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) GetDepth(context.Context, *GetDepthRequest) (*GetDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepth not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) CreateScheduledOrder(context.Context, *CreateScheduledOrderRequest) (*ScheduledOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledOrder not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) CancelScheduledOrder(context.Context, *ScheduledOrderRequest) (*ScheduledOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledOrder not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) GetScheduledOrder(context.Context, *ScheduledOrderRequest) (*ScheduledOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledOrder not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) PlaceLimitOrder(context.Context, *PlaceLimitOrderRequest) (*LimitOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) CancelLimitOrder(context.Context, *LimitOrderRequest) (*LimitOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) GetLimitOrder(context.Context, *LimitOrderRequest) (*LimitOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimitOrder not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) CreateTrigger(context.Context, *CreateTriggerRequest) (*Trigger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrigger not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) CancelTrigger(context.Context, *TriggerRequest) (*Trigger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTrigger not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) GetTrigger(context.Context, *TriggerRequest) (*Trigger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrigger not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) ListTriggerEvaluations(*TriggerRequest, grpc.ServerStreamingServer[TriggerEvaluation]) error {
+	return status.Errorf(codes.Unimplemented, "method ListTriggerEvaluations not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) CreateDcaPlan(context.Context, *CreateDcaPlanRequest) (*DcaPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDcaPlan not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) CancelDcaPlan(context.Context, *DcaPlanRequest) (*DcaPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDcaPlan not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) GetDcaPlan(context.Context, *DcaPlanRequest) (*DcaPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDcaPlan not implemented")
+}
+func (UnimplementedQuoteSwapServiceServer) ListDcaPlans(context.Context, *ListDcaPlansRequest) (*ListDcaPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDcaPlans not implemented")
+}
 func (UnimplementedQuoteSwapServiceServer) mustEmbedUnimplementedQuoteSwapServiceServer() {}
 func (UnimplementedQuoteSwapServiceServer) testEmbeddedByValue()                          {}
 
@@ -139,6 +509,388 @@ func _QuoteSwapService_ExecuteSwap_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuoteSwapService_BatchGetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).BatchGetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_BatchGetQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).BatchGetQuote(ctx, req.(*BatchGetQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_GetToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).GetToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_GetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).GetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_GetBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).GetBalances(ctx, req.(*GetBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_ListVenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).ListVenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_ListVenues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).ListVenues(ctx, req.(*ListVenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_GetHistoricalQuotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetHistoricalQuotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuoteSwapServiceServer).GetHistoricalQuotes(m, &grpc.GenericServerStream[GetHistoricalQuotesRequest, HistoricalQuote]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuoteSwapService_GetHistoricalQuotesServer = grpc.ServerStreamingServer[HistoricalQuote]
+
+func _QuoteSwapService_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).ListPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_ListPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).ListPools(ctx, req.(*ListPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_GetTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).GetTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_GetTwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).GetTwap(ctx, req.(*GetTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_GetDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).GetDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_GetDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).GetDepth(ctx, req.(*GetDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_CreateScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).CreateScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_CreateScheduledOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).CreateScheduledOrder(ctx, req.(*CreateScheduledOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_CancelScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).CancelScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_CancelScheduledOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).CancelScheduledOrder(ctx, req.(*ScheduledOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_GetScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).GetScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_GetScheduledOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).GetScheduledOrder(ctx, req.(*ScheduledOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceLimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_PlaceLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).PlaceLimitOrder(ctx, req.(*PlaceLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_CancelLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).CancelLimitOrder(ctx, req.(*LimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_GetLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).GetLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_GetLimitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).GetLimitOrder(ctx, req.(*LimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_CreateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).CreateTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_CreateTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).CreateTrigger(ctx, req.(*CreateTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_CancelTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).CancelTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_CancelTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).CancelTrigger(ctx, req.(*TriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_GetTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).GetTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_GetTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).GetTrigger(ctx, req.(*TriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_ListTriggerEvaluations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TriggerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuoteSwapServiceServer).ListTriggerEvaluations(m, &grpc.GenericServerStream[TriggerRequest, TriggerEvaluation]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuoteSwapService_ListTriggerEvaluationsServer = grpc.ServerStreamingServer[TriggerEvaluation]
+
+func _QuoteSwapService_CreateDcaPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDcaPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).CreateDcaPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_CreateDcaPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).CreateDcaPlan(ctx, req.(*CreateDcaPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_CancelDcaPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DcaPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).CancelDcaPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_CancelDcaPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).CancelDcaPlan(ctx, req.(*DcaPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_GetDcaPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DcaPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).GetDcaPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_GetDcaPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).GetDcaPlan(ctx, req.(*DcaPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuoteSwapService_ListDcaPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDcaPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuoteSwapServiceServer).ListDcaPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuoteSwapService_ListDcaPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuoteSwapServiceServer).ListDcaPlans(ctx, req.(*ListDcaPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuoteSwapService_ServiceDesc is the grpc.ServiceDesc for QuoteSwapService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,7 +906,98 @@ var QuoteSwapService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteSwap",
 			Handler:    _QuoteSwapService_ExecuteSwap_Handler,
 		},
+		{
+			MethodName: "BatchGetQuote",
+			Handler:    _QuoteSwapService_BatchGetQuote_Handler,
+		},
+		{
+			MethodName: "GetToken",
+			Handler:    _QuoteSwapService_GetToken_Handler,
+		},
+		{
+			MethodName: "GetBalances",
+			Handler:    _QuoteSwapService_GetBalances_Handler,
+		},
+		{
+			MethodName: "ListVenues",
+			Handler:    _QuoteSwapService_ListVenues_Handler,
+		},
+		{
+			MethodName: "ListPools",
+			Handler:    _QuoteSwapService_ListPools_Handler,
+		},
+		{
+			MethodName: "GetTwap",
+			Handler:    _QuoteSwapService_GetTwap_Handler,
+		},
+		{
+			MethodName: "GetDepth",
+			Handler:    _QuoteSwapService_GetDepth_Handler,
+		},
+		{
+			MethodName: "CreateScheduledOrder",
+			Handler:    _QuoteSwapService_CreateScheduledOrder_Handler,
+		},
+		{
+			MethodName: "CancelScheduledOrder",
+			Handler:    _QuoteSwapService_CancelScheduledOrder_Handler,
+		},
+		{
+			MethodName: "GetScheduledOrder",
+			Handler:    _QuoteSwapService_GetScheduledOrder_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _QuoteSwapService_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _QuoteSwapService_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "GetLimitOrder",
+			Handler:    _QuoteSwapService_GetLimitOrder_Handler,
+		},
+		{
+			MethodName: "CreateTrigger",
+			Handler:    _QuoteSwapService_CreateTrigger_Handler,
+		},
+		{
+			MethodName: "CancelTrigger",
+			Handler:    _QuoteSwapService_CancelTrigger_Handler,
+		},
+		{
+			MethodName: "GetTrigger",
+			Handler:    _QuoteSwapService_GetTrigger_Handler,
+		},
+		{
+			MethodName: "CreateDcaPlan",
+			Handler:    _QuoteSwapService_CreateDcaPlan_Handler,
+		},
+		{
+			MethodName: "CancelDcaPlan",
+			Handler:    _QuoteSwapService_CancelDcaPlan_Handler,
+		},
+		{
+			MethodName: "GetDcaPlan",
+			Handler:    _QuoteSwapService_GetDcaPlan_Handler,
+		},
+		{
+			MethodName: "ListDcaPlans",
+			Handler:    _QuoteSwapService_ListDcaPlans_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetHistoricalQuotes",
+			Handler:       _QuoteSwapService_GetHistoricalQuotes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTriggerEvaluations",
+			Handler:       _QuoteSwapService_ListTriggerEvaluations_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "quoteswap/quoteswap.proto",
 }
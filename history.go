@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/registry"
+)
+
+// historyHeader is the CSV header written by the history subcommand.
+var historyHeader = []string{"block", "timestamp", "amount_in", "amount_out", "amount_out_decimal", "price", "error"}
+
+// runHistory implements the history subcommand: it backfills a quote over a block range and
+// writes one CSV row per block, e.g.
+//
+//	go run . history -chain bsc -dex v2 -token-in 0x... -token-out 0x... -amount-decimal 1 -from 40000000 -to 40100000 -step 1000 -out quotes.csv
+func runHistory(reg *registry.Registry, args []string) error {
+	flags := flag.NewFlagSet("history", flag.ContinueOnError)
+	var (
+		chain         = flags.String("chain", "", "chain to quote on")
+		dex           = flags.String("dex", "v2", "dex version, v2 or v3")
+		tokenIn       = flags.String("token-in", "", "input token address")
+		tokenOut      = flags.String("token-out", "", "output token address")
+		amount        = flags.String("amount", "", "input amount in base units")
+		amountDecimal = flags.String("amount-decimal", "", "input amount in whole tokens, used when -amount is not set")
+		from          = flags.Uint64("from", 0, "first block")
+		to            = flags.Uint64("to", 0, "last block, inclusive (default: -from)")
+		step          = flags.Uint64("step", 1, "blocks between two quotes")
+		out           = flags.String("out", "", "output file (default: stdout)")
+		format        = flags.String("format", "csv", "output format, only csv is supported")
+	)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *format != "csv" {
+		return fmt.Errorf("unsupported format %q, only csv is supported", *format)
+	}
+	if *chain == "" || *tokenIn == "" || *tokenOut == "" || (*amount == "" && *amountDecimal == "") {
+		return errors.New("-chain, -token-in, -token-out and -amount or -amount-decimal are required")
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	srv := newServer(reg)
+	defer func() {
+		for _, client := range srv.Clients {
+			client.Close()
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(historyHeader); err != nil {
+		return err
+	}
+
+	req := &quoteswap.GetHistoricalQuotesRequest{
+		Quote: &quoteswap.GetQuoteRequest{
+			TokenIn:       *tokenIn,
+			TokenOut:      *tokenOut,
+			AmountIn:      *amount,
+			AmountDecimal: *amountDecimal,
+			Dex:           *dex,
+			Chain:         *chain,
+		},
+		FromBlock: *from,
+		ToBlock:   *to,
+		Step:      *step,
+	}
+
+	err := srv.HistoricalQuotes(ctx, req, func(point *quoteswap.HistoricalQuote) error {
+		var message string
+		if point.GetError() != nil {
+			message = point.GetError().GetMessage()
+		}
+
+		return writer.Write([]string{
+			strconv.FormatUint(point.GetBlockNumber(), 10),
+			strconv.FormatUint(point.GetBlockTimestamp(), 10),
+			point.GetInAmount(),
+			point.GetOutAmount(),
+			point.GetOutAmountDecimal(),
+			point.GetPrice(),
+			message,
+		})
+	})
+
+	writer.Flush()
+	if err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
@@ -0,0 +1,543 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package factoryV2
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// BlockchainMetaData contains all meta data concerning the Blockchain contract.
+var BlockchainMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"token0\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token1\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"PairCreated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"INIT_CODE_PAIR_HASH\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"allPairs\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"allPairsLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"tokenA\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenB\",\"type\":\"address\"}],\"name\":\"createPair\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"pair\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"feeTo\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"feeToSetter\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"getPair\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// BlockchainABI is the input ABI used to generate the binding from.
+// Deprecated: Use BlockchainMetaData.ABI instead.
+var BlockchainABI = BlockchainMetaData.ABI
+
+// Blockchain is an auto generated Go binding around an Ethereum contract.
+type Blockchain struct {
+	BlockchainCaller     // Read-only binding to the contract
+	BlockchainTransactor // Write-only binding to the contract
+	BlockchainFilterer   // Log filterer for contract events
+}
+
+// BlockchainCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BlockchainCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockchainTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BlockchainTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockchainFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BlockchainFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockchainSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BlockchainSession struct {
+	Contract     *Blockchain       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BlockchainCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BlockchainCallerSession struct {
+	Contract *BlockchainCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// BlockchainTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BlockchainTransactorSession struct {
+	Contract     *BlockchainTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// BlockchainRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BlockchainRaw struct {
+	Contract *Blockchain // Generic contract binding to access the raw methods on
+}
+
+// BlockchainCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BlockchainCallerRaw struct {
+	Contract *BlockchainCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BlockchainTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BlockchainTransactorRaw struct {
+	Contract *BlockchainTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBlockchain creates a new instance of Blockchain, bound to a specific deployed contract.
+func NewBlockchain(address common.Address, backend bind.ContractBackend) (*Blockchain, error) {
+	contract, err := bindBlockchain(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Blockchain{BlockchainCaller: BlockchainCaller{contract: contract}, BlockchainTransactor: BlockchainTransactor{contract: contract}, BlockchainFilterer: BlockchainFilterer{contract: contract}}, nil
+}
+
+// NewBlockchainCaller creates a new read-only instance of Blockchain, bound to a specific deployed contract.
+func NewBlockchainCaller(address common.Address, caller bind.ContractCaller) (*BlockchainCaller, error) {
+	contract, err := bindBlockchain(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainCaller{contract: contract}, nil
+}
+
+// NewBlockchainTransactor creates a new write-only instance of Blockchain, bound to a specific deployed contract.
+func NewBlockchainTransactor(address common.Address, transactor bind.ContractTransactor) (*BlockchainTransactor, error) {
+	contract, err := bindBlockchain(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainTransactor{contract: contract}, nil
+}
+
+// NewBlockchainFilterer creates a new log filterer instance of Blockchain, bound to a specific deployed contract.
+func NewBlockchainFilterer(address common.Address, filterer bind.ContractFilterer) (*BlockchainFilterer, error) {
+	contract, err := bindBlockchain(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainFilterer{contract: contract}, nil
+}
+
+// bindBlockchain binds a generic wrapper to an already deployed contract.
+func bindBlockchain(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BlockchainMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Blockchain *BlockchainRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Blockchain.Contract.BlockchainCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Blockchain *BlockchainRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Blockchain.Contract.BlockchainTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Blockchain *BlockchainRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Blockchain.Contract.BlockchainTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Blockchain *BlockchainCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Blockchain.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Blockchain *BlockchainTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Blockchain.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Blockchain *BlockchainTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Blockchain.Contract.contract.Transact(opts, method, params...)
+}
+
+// INITCODEPAIRHASH is a free data retrieval call binding the contract method 0x5855a25a.
+//
+// Solidity: function INIT_CODE_PAIR_HASH() view returns(bytes32)
+func (_Blockchain *BlockchainCaller) INITCODEPAIRHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "INIT_CODE_PAIR_HASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// INITCODEPAIRHASH is a free data retrieval call binding the contract method 0x5855a25a.
+//
+// Solidity: function INIT_CODE_PAIR_HASH() view returns(bytes32)
+func (_Blockchain *BlockchainSession) INITCODEPAIRHASH() ([32]byte, error) {
+	return _Blockchain.Contract.INITCODEPAIRHASH(&_Blockchain.CallOpts)
+}
+
+// INITCODEPAIRHASH is a free data retrieval call binding the contract method 0x5855a25a.
+//
+// Solidity: function INIT_CODE_PAIR_HASH() view returns(bytes32)
+func (_Blockchain *BlockchainCallerSession) INITCODEPAIRHASH() ([32]byte, error) {
+	return _Blockchain.Contract.INITCODEPAIRHASH(&_Blockchain.CallOpts)
+}
+
+// AllPairs is a free data retrieval call binding the contract method 0x1e3dd18b.
+//
+// Solidity: function allPairs(uint256 ) view returns(address)
+func (_Blockchain *BlockchainCaller) AllPairs(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "allPairs", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// AllPairs is a free data retrieval call binding the contract method 0x1e3dd18b.
+//
+// Solidity: function allPairs(uint256 ) view returns(address)
+func (_Blockchain *BlockchainSession) AllPairs(arg0 *big.Int) (common.Address, error) {
+	return _Blockchain.Contract.AllPairs(&_Blockchain.CallOpts, arg0)
+}
+
+// AllPairs is a free data retrieval call binding the contract method 0x1e3dd18b.
+//
+// Solidity: function allPairs(uint256 ) view returns(address)
+func (_Blockchain *BlockchainCallerSession) AllPairs(arg0 *big.Int) (common.Address, error) {
+	return _Blockchain.Contract.AllPairs(&_Blockchain.CallOpts, arg0)
+}
+
+// AllPairsLength is a free data retrieval call binding the contract method 0x574f2ba3.
+//
+// Solidity: function allPairsLength() view returns(uint256)
+func (_Blockchain *BlockchainCaller) AllPairsLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "allPairsLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// AllPairsLength is a free data retrieval call binding the contract method 0x574f2ba3.
+//
+// Solidity: function allPairsLength() view returns(uint256)
+func (_Blockchain *BlockchainSession) AllPairsLength() (*big.Int, error) {
+	return _Blockchain.Contract.AllPairsLength(&_Blockchain.CallOpts)
+}
+
+// AllPairsLength is a free data retrieval call binding the contract method 0x574f2ba3.
+//
+// Solidity: function allPairsLength() view returns(uint256)
+func (_Blockchain *BlockchainCallerSession) AllPairsLength() (*big.Int, error) {
+	return _Blockchain.Contract.AllPairsLength(&_Blockchain.CallOpts)
+}
+
+// FeeTo is a free data retrieval call binding the contract method 0x017e7e58.
+//
+// Solidity: function feeTo() view returns(address)
+func (_Blockchain *BlockchainCaller) FeeTo(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "feeTo")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FeeTo is a free data retrieval call binding the contract method 0x017e7e58.
+//
+// Solidity: function feeTo() view returns(address)
+func (_Blockchain *BlockchainSession) FeeTo() (common.Address, error) {
+	return _Blockchain.Contract.FeeTo(&_Blockchain.CallOpts)
+}
+
+// FeeTo is a free data retrieval call binding the contract method 0x017e7e58.
+//
+// Solidity: function feeTo() view returns(address)
+func (_Blockchain *BlockchainCallerSession) FeeTo() (common.Address, error) {
+	return _Blockchain.Contract.FeeTo(&_Blockchain.CallOpts)
+}
+
+// FeeToSetter is a free data retrieval call binding the contract method 0x094b7415.
+//
+// Solidity: function feeToSetter() view returns(address)
+func (_Blockchain *BlockchainCaller) FeeToSetter(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "feeToSetter")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FeeToSetter is a free data retrieval call binding the contract method 0x094b7415.
+//
+// Solidity: function feeToSetter() view returns(address)
+func (_Blockchain *BlockchainSession) FeeToSetter() (common.Address, error) {
+	return _Blockchain.Contract.FeeToSetter(&_Blockchain.CallOpts)
+}
+
+// FeeToSetter is a free data retrieval call binding the contract method 0x094b7415.
+//
+// Solidity: function feeToSetter() view returns(address)
+func (_Blockchain *BlockchainCallerSession) FeeToSetter() (common.Address, error) {
+	return _Blockchain.Contract.FeeToSetter(&_Blockchain.CallOpts)
+}
+
+// GetPair is a free data retrieval call binding the contract method 0xe6a43905.
+//
+// Solidity: function getPair(address , address ) view returns(address)
+func (_Blockchain *BlockchainCaller) GetPair(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "getPair", arg0, arg1)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetPair is a free data retrieval call binding the contract method 0xe6a43905.
+//
+// Solidity: function getPair(address , address ) view returns(address)
+func (_Blockchain *BlockchainSession) GetPair(arg0 common.Address, arg1 common.Address) (common.Address, error) {
+	return _Blockchain.Contract.GetPair(&_Blockchain.CallOpts, arg0, arg1)
+}
+
+// GetPair is a free data retrieval call binding the contract method 0xe6a43905.
+//
+// Solidity: function getPair(address , address ) view returns(address)
+func (_Blockchain *BlockchainCallerSession) GetPair(arg0 common.Address, arg1 common.Address) (common.Address, error) {
+	return _Blockchain.Contract.GetPair(&_Blockchain.CallOpts, arg0, arg1)
+}
+
+// CreatePair is a paid mutator transaction binding the contract method 0xc9c65396.
+//
+// Solidity: function createPair(address tokenA, address tokenB) returns(address pair)
+func (_Blockchain *BlockchainTransactor) CreatePair(opts *bind.TransactOpts, tokenA common.Address, tokenB common.Address) (*types.Transaction, error) {
+	return _Blockchain.contract.Transact(opts, "createPair", tokenA, tokenB)
+}
+
+// CreatePair is a paid mutator transaction binding the contract method 0xc9c65396.
+//
+// Solidity: function createPair(address tokenA, address tokenB) returns(address pair)
+func (_Blockchain *BlockchainSession) CreatePair(tokenA common.Address, tokenB common.Address) (*types.Transaction, error) {
+	return _Blockchain.Contract.CreatePair(&_Blockchain.TransactOpts, tokenA, tokenB)
+}
+
+// CreatePair is a paid mutator transaction binding the contract method 0xc9c65396.
+//
+// Solidity: function createPair(address tokenA, address tokenB) returns(address pair)
+func (_Blockchain *BlockchainTransactorSession) CreatePair(tokenA common.Address, tokenB common.Address) (*types.Transaction, error) {
+	return _Blockchain.Contract.CreatePair(&_Blockchain.TransactOpts, tokenA, tokenB)
+}
+
+// BlockchainPairCreatedIterator is returned from FilterPairCreated and is used to iterate over the raw logs and unpacked data for PairCreated events raised by the Blockchain contract.
+type BlockchainPairCreatedIterator struct {
+	Event *BlockchainPairCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BlockchainPairCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BlockchainPairCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BlockchainPairCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BlockchainPairCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BlockchainPairCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BlockchainPairCreated represents a PairCreated event raised by the Blockchain contract.
+type BlockchainPairCreated struct {
+	Token0 common.Address
+	Token1 common.Address
+	Pair   common.Address
+	Arg3   *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterPairCreated is a free log retrieval operation binding the contract event 0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9.
+//
+// Solidity: event PairCreated(address indexed token0, address indexed token1, address pair, uint256 arg3)
+func (_Blockchain *BlockchainFilterer) FilterPairCreated(opts *bind.FilterOpts, token0 []common.Address, token1 []common.Address) (*BlockchainPairCreatedIterator, error) {
+
+	var token0Rule []interface{}
+	for _, token0Item := range token0 {
+		token0Rule = append(token0Rule, token0Item)
+	}
+	var token1Rule []interface{}
+	for _, token1Item := range token1 {
+		token1Rule = append(token1Rule, token1Item)
+	}
+
+	logs, sub, err := _Blockchain.contract.FilterLogs(opts, "PairCreated", token0Rule, token1Rule)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainPairCreatedIterator{contract: _Blockchain.contract, event: "PairCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchPairCreated is a free log subscription operation binding the contract event 0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9.
+//
+// Solidity: event PairCreated(address indexed token0, address indexed token1, address pair, uint256 arg3)
+func (_Blockchain *BlockchainFilterer) WatchPairCreated(opts *bind.WatchOpts, sink chan<- *BlockchainPairCreated, token0 []common.Address, token1 []common.Address) (event.Subscription, error) {
+
+	var token0Rule []interface{}
+	for _, token0Item := range token0 {
+		token0Rule = append(token0Rule, token0Item)
+	}
+	var token1Rule []interface{}
+	for _, token1Item := range token1 {
+		token1Rule = append(token1Rule, token1Item)
+	}
+
+	logs, sub, err := _Blockchain.contract.WatchLogs(opts, "PairCreated", token0Rule, token1Rule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BlockchainPairCreated)
+				if err := _Blockchain.contract.UnpackLog(event, "PairCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePairCreated is a log parse operation binding the contract event 0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9.
+//
+// Solidity: event PairCreated(address indexed token0, address indexed token1, address pair, uint256 arg3)
+func (_Blockchain *BlockchainFilterer) ParsePairCreated(log types.Log) (*BlockchainPairCreated, error) {
+	event := new(BlockchainPairCreated)
+	if err := _Blockchain.contract.UnpackLog(event, "PairCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,872 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package factoryV3
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// BlockchainMetaData contains all meta data concerning the Blockchain contract.
+var BlockchainMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint24\",\"name\":\"fee\",\"type\":\"uint24\",\"indexed\":true},{\"internalType\":\"int24\",\"name\":\"tickSpacing\",\"type\":\"int24\",\"indexed\":true}],\"name\":\"FeeAmountEnabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"oldOwner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true}],\"name\":\"OwnerChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"token0\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token1\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint24\",\"name\":\"fee\",\"type\":\"uint24\",\"indexed\":true},{\"internalType\":\"int24\",\"name\":\"tickSpacing\",\"type\":\"int24\",\"indexed\":false},{\"internalType\":\"address\",\"name\":\"pool\",\"type\":\"address\",\"indexed\":false}],\"name\":\"PoolCreated\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"tokenA\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenB\",\"type\":\"address\"},{\"internalType\":\"uint24\",\"name\":\"fee\",\"type\":\"uint24\"}],\"name\":\"createPool\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"pool\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint24\",\"name\":\"\",\"type\":\"uint24\"}],\"name\":\"feeAmountTickSpacing\",\"outputs\":[{\"internalType\":\"int24\",\"name\":\"\",\"type\":\"int24\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint24\",\"name\":\"\",\"type\":\"uint24\"}],\"name\":\"feeAmountTickSpacingExtraInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"whitelistRequested\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint24\",\"name\":\"\",\"type\":\"uint24\"}],\"name\":\"getPool\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lmPoolDeployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"poolDeployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// BlockchainABI is the input ABI used to generate the binding from.
+// Deprecated: Use BlockchainMetaData.ABI instead.
+var BlockchainABI = BlockchainMetaData.ABI
+
+// Blockchain is an auto generated Go binding around an Ethereum contract.
+type Blockchain struct {
+	BlockchainCaller     // Read-only binding to the contract
+	BlockchainTransactor // Write-only binding to the contract
+	BlockchainFilterer   // Log filterer for contract events
+}
+
+// BlockchainCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BlockchainCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockchainTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BlockchainTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockchainFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BlockchainFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockchainSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BlockchainSession struct {
+	Contract     *Blockchain       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BlockchainCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BlockchainCallerSession struct {
+	Contract *BlockchainCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// BlockchainTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BlockchainTransactorSession struct {
+	Contract     *BlockchainTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// BlockchainRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BlockchainRaw struct {
+	Contract *Blockchain // Generic contract binding to access the raw methods on
+}
+
+// BlockchainCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BlockchainCallerRaw struct {
+	Contract *BlockchainCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BlockchainTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BlockchainTransactorRaw struct {
+	Contract *BlockchainTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBlockchain creates a new instance of Blockchain, bound to a specific deployed contract.
+func NewBlockchain(address common.Address, backend bind.ContractBackend) (*Blockchain, error) {
+	contract, err := bindBlockchain(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Blockchain{BlockchainCaller: BlockchainCaller{contract: contract}, BlockchainTransactor: BlockchainTransactor{contract: contract}, BlockchainFilterer: BlockchainFilterer{contract: contract}}, nil
+}
+
+// NewBlockchainCaller creates a new read-only instance of Blockchain, bound to a specific deployed contract.
+func NewBlockchainCaller(address common.Address, caller bind.ContractCaller) (*BlockchainCaller, error) {
+	contract, err := bindBlockchain(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainCaller{contract: contract}, nil
+}
+
+// NewBlockchainTransactor creates a new write-only instance of Blockchain, bound to a specific deployed contract.
+func NewBlockchainTransactor(address common.Address, transactor bind.ContractTransactor) (*BlockchainTransactor, error) {
+	contract, err := bindBlockchain(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainTransactor{contract: contract}, nil
+}
+
+// NewBlockchainFilterer creates a new log filterer instance of Blockchain, bound to a specific deployed contract.
+func NewBlockchainFilterer(address common.Address, filterer bind.ContractFilterer) (*BlockchainFilterer, error) {
+	contract, err := bindBlockchain(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainFilterer{contract: contract}, nil
+}
+
+// bindBlockchain binds a generic wrapper to an already deployed contract.
+func bindBlockchain(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BlockchainMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Blockchain *BlockchainRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Blockchain.Contract.BlockchainCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Blockchain *BlockchainRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Blockchain.Contract.BlockchainTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Blockchain *BlockchainRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Blockchain.Contract.BlockchainTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Blockchain *BlockchainCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Blockchain.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Blockchain *BlockchainTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Blockchain.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Blockchain *BlockchainTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Blockchain.Contract.contract.Transact(opts, method, params...)
+}
+
+// FeeAmountTickSpacing is a free data retrieval call binding the contract method 0x22afcccb.
+//
+// Solidity: function feeAmountTickSpacing(uint24 ) view returns(int24)
+func (_Blockchain *BlockchainCaller) FeeAmountTickSpacing(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "feeAmountTickSpacing", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FeeAmountTickSpacing is a free data retrieval call binding the contract method 0x22afcccb.
+//
+// Solidity: function feeAmountTickSpacing(uint24 ) view returns(int24)
+func (_Blockchain *BlockchainSession) FeeAmountTickSpacing(arg0 *big.Int) (*big.Int, error) {
+	return _Blockchain.Contract.FeeAmountTickSpacing(&_Blockchain.CallOpts, arg0)
+}
+
+// FeeAmountTickSpacing is a free data retrieval call binding the contract method 0x22afcccb.
+//
+// Solidity: function feeAmountTickSpacing(uint24 ) view returns(int24)
+func (_Blockchain *BlockchainCallerSession) FeeAmountTickSpacing(arg0 *big.Int) (*big.Int, error) {
+	return _Blockchain.Contract.FeeAmountTickSpacing(&_Blockchain.CallOpts, arg0)
+}
+
+// FeeAmountTickSpacingExtraInfo is a free data retrieval call binding the contract method 0x88e8006d.
+//
+// Solidity: function feeAmountTickSpacingExtraInfo(uint24 ) view returns(bool whitelistRequested, bool enabled)
+func (_Blockchain *BlockchainCaller) FeeAmountTickSpacingExtraInfo(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	WhitelistRequested bool
+	Enabled            bool
+}, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "feeAmountTickSpacingExtraInfo", arg0)
+
+	outstruct := new(struct {
+		WhitelistRequested bool
+		Enabled            bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.WhitelistRequested = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Enabled = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// FeeAmountTickSpacingExtraInfo is a free data retrieval call binding the contract method 0x88e8006d.
+//
+// Solidity: function feeAmountTickSpacingExtraInfo(uint24 ) view returns(bool whitelistRequested, bool enabled)
+func (_Blockchain *BlockchainSession) FeeAmountTickSpacingExtraInfo(arg0 *big.Int) (struct {
+	WhitelistRequested bool
+	Enabled            bool
+}, error) {
+	return _Blockchain.Contract.FeeAmountTickSpacingExtraInfo(&_Blockchain.CallOpts, arg0)
+}
+
+// FeeAmountTickSpacingExtraInfo is a free data retrieval call binding the contract method 0x88e8006d.
+//
+// Solidity: function feeAmountTickSpacingExtraInfo(uint24 ) view returns(bool whitelistRequested, bool enabled)
+func (_Blockchain *BlockchainCallerSession) FeeAmountTickSpacingExtraInfo(arg0 *big.Int) (struct {
+	WhitelistRequested bool
+	Enabled            bool
+}, error) {
+	return _Blockchain.Contract.FeeAmountTickSpacingExtraInfo(&_Blockchain.CallOpts, arg0)
+}
+
+// GetPool is a free data retrieval call binding the contract method 0x1698ee82.
+//
+// Solidity: function getPool(address , address , uint24 ) view returns(address)
+func (_Blockchain *BlockchainCaller) GetPool(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address, arg2 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "getPool", arg0, arg1, arg2)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetPool is a free data retrieval call binding the contract method 0x1698ee82.
+//
+// Solidity: function getPool(address , address , uint24 ) view returns(address)
+func (_Blockchain *BlockchainSession) GetPool(arg0 common.Address, arg1 common.Address, arg2 *big.Int) (common.Address, error) {
+	return _Blockchain.Contract.GetPool(&_Blockchain.CallOpts, arg0, arg1, arg2)
+}
+
+// GetPool is a free data retrieval call binding the contract method 0x1698ee82.
+//
+// Solidity: function getPool(address , address , uint24 ) view returns(address)
+func (_Blockchain *BlockchainCallerSession) GetPool(arg0 common.Address, arg1 common.Address, arg2 *big.Int) (common.Address, error) {
+	return _Blockchain.Contract.GetPool(&_Blockchain.CallOpts, arg0, arg1, arg2)
+}
+
+// LmPoolDeployer is a free data retrieval call binding the contract method 0x5e492ac8.
+//
+// Solidity: function lmPoolDeployer() view returns(address)
+func (_Blockchain *BlockchainCaller) LmPoolDeployer(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "lmPoolDeployer")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LmPoolDeployer is a free data retrieval call binding the contract method 0x5e492ac8.
+//
+// Solidity: function lmPoolDeployer() view returns(address)
+func (_Blockchain *BlockchainSession) LmPoolDeployer() (common.Address, error) {
+	return _Blockchain.Contract.LmPoolDeployer(&_Blockchain.CallOpts)
+}
+
+// LmPoolDeployer is a free data retrieval call binding the contract method 0x5e492ac8.
+//
+// Solidity: function lmPoolDeployer() view returns(address)
+func (_Blockchain *BlockchainCallerSession) LmPoolDeployer() (common.Address, error) {
+	return _Blockchain.Contract.LmPoolDeployer(&_Blockchain.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Blockchain *BlockchainCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Blockchain *BlockchainSession) Owner() (common.Address, error) {
+	return _Blockchain.Contract.Owner(&_Blockchain.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Blockchain *BlockchainCallerSession) Owner() (common.Address, error) {
+	return _Blockchain.Contract.Owner(&_Blockchain.CallOpts)
+}
+
+// PoolDeployer is a free data retrieval call binding the contract method 0x3119049a.
+//
+// Solidity: function poolDeployer() view returns(address)
+func (_Blockchain *BlockchainCaller) PoolDeployer(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Blockchain.contract.Call(opts, &out, "poolDeployer")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PoolDeployer is a free data retrieval call binding the contract method 0x3119049a.
+//
+// Solidity: function poolDeployer() view returns(address)
+func (_Blockchain *BlockchainSession) PoolDeployer() (common.Address, error) {
+	return _Blockchain.Contract.PoolDeployer(&_Blockchain.CallOpts)
+}
+
+// PoolDeployer is a free data retrieval call binding the contract method 0x3119049a.
+//
+// Solidity: function poolDeployer() view returns(address)
+func (_Blockchain *BlockchainCallerSession) PoolDeployer() (common.Address, error) {
+	return _Blockchain.Contract.PoolDeployer(&_Blockchain.CallOpts)
+}
+
+// CreatePool is a paid mutator transaction binding the contract method 0xa1671295.
+//
+// Solidity: function createPool(address tokenA, address tokenB, uint24 fee) returns(address pool)
+func (_Blockchain *BlockchainTransactor) CreatePool(opts *bind.TransactOpts, tokenA common.Address, tokenB common.Address, fee *big.Int) (*types.Transaction, error) {
+	return _Blockchain.contract.Transact(opts, "createPool", tokenA, tokenB, fee)
+}
+
+// CreatePool is a paid mutator transaction binding the contract method 0xa1671295.
+//
+// Solidity: function createPool(address tokenA, address tokenB, uint24 fee) returns(address pool)
+func (_Blockchain *BlockchainSession) CreatePool(tokenA common.Address, tokenB common.Address, fee *big.Int) (*types.Transaction, error) {
+	return _Blockchain.Contract.CreatePool(&_Blockchain.TransactOpts, tokenA, tokenB, fee)
+}
+
+// CreatePool is a paid mutator transaction binding the contract method 0xa1671295.
+//
+// Solidity: function createPool(address tokenA, address tokenB, uint24 fee) returns(address pool)
+func (_Blockchain *BlockchainTransactorSession) CreatePool(tokenA common.Address, tokenB common.Address, fee *big.Int) (*types.Transaction, error) {
+	return _Blockchain.Contract.CreatePool(&_Blockchain.TransactOpts, tokenA, tokenB, fee)
+}
+
+// BlockchainFeeAmountEnabledIterator is returned from FilterFeeAmountEnabled and is used to iterate over the raw logs and unpacked data for FeeAmountEnabled events raised by the Blockchain contract.
+type BlockchainFeeAmountEnabledIterator struct {
+	Event *BlockchainFeeAmountEnabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BlockchainFeeAmountEnabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BlockchainFeeAmountEnabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BlockchainFeeAmountEnabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BlockchainFeeAmountEnabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BlockchainFeeAmountEnabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BlockchainFeeAmountEnabled represents a FeeAmountEnabled event raised by the Blockchain contract.
+type BlockchainFeeAmountEnabled struct {
+	Fee         *big.Int
+	TickSpacing *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeeAmountEnabled is a free log retrieval operation binding the contract event 0xc66a3fdf07232cdd185febcc6579d408c241b47ae2f9907d84be655141eeaecc.
+//
+// Solidity: event FeeAmountEnabled(uint24 indexed fee, int24 indexed tickSpacing)
+func (_Blockchain *BlockchainFilterer) FilterFeeAmountEnabled(opts *bind.FilterOpts, fee []*big.Int, tickSpacing []*big.Int) (*BlockchainFeeAmountEnabledIterator, error) {
+
+	var feeRule []interface{}
+	for _, feeItem := range fee {
+		feeRule = append(feeRule, feeItem)
+	}
+	var tickSpacingRule []interface{}
+	for _, tickSpacingItem := range tickSpacing {
+		tickSpacingRule = append(tickSpacingRule, tickSpacingItem)
+	}
+
+	logs, sub, err := _Blockchain.contract.FilterLogs(opts, "FeeAmountEnabled", feeRule, tickSpacingRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainFeeAmountEnabledIterator{contract: _Blockchain.contract, event: "FeeAmountEnabled", logs: logs, sub: sub}, nil
+}
+
+// WatchFeeAmountEnabled is a free log subscription operation binding the contract event 0xc66a3fdf07232cdd185febcc6579d408c241b47ae2f9907d84be655141eeaecc.
+//
+// Solidity: event FeeAmountEnabled(uint24 indexed fee, int24 indexed tickSpacing)
+func (_Blockchain *BlockchainFilterer) WatchFeeAmountEnabled(opts *bind.WatchOpts, sink chan<- *BlockchainFeeAmountEnabled, fee []*big.Int, tickSpacing []*big.Int) (event.Subscription, error) {
+
+	var feeRule []interface{}
+	for _, feeItem := range fee {
+		feeRule = append(feeRule, feeItem)
+	}
+	var tickSpacingRule []interface{}
+	for _, tickSpacingItem := range tickSpacing {
+		tickSpacingRule = append(tickSpacingRule, tickSpacingItem)
+	}
+
+	logs, sub, err := _Blockchain.contract.WatchLogs(opts, "FeeAmountEnabled", feeRule, tickSpacingRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BlockchainFeeAmountEnabled)
+				if err := _Blockchain.contract.UnpackLog(event, "FeeAmountEnabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeeAmountEnabled is a log parse operation binding the contract event 0xc66a3fdf07232cdd185febcc6579d408c241b47ae2f9907d84be655141eeaecc.
+//
+// Solidity: event FeeAmountEnabled(uint24 indexed fee, int24 indexed tickSpacing)
+func (_Blockchain *BlockchainFilterer) ParseFeeAmountEnabled(log types.Log) (*BlockchainFeeAmountEnabled, error) {
+	event := new(BlockchainFeeAmountEnabled)
+	if err := _Blockchain.contract.UnpackLog(event, "FeeAmountEnabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BlockchainOwnerChangedIterator is returned from FilterOwnerChanged and is used to iterate over the raw logs and unpacked data for OwnerChanged events raised by the Blockchain contract.
+type BlockchainOwnerChangedIterator struct {
+	Event *BlockchainOwnerChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BlockchainOwnerChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BlockchainOwnerChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BlockchainOwnerChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BlockchainOwnerChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BlockchainOwnerChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BlockchainOwnerChanged represents a OwnerChanged event raised by the Blockchain contract.
+type BlockchainOwnerChanged struct {
+	OldOwner common.Address
+	NewOwner common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnerChanged is a free log retrieval operation binding the contract event 0xb532073b38c83145e3e5135377a08bf9aab55bc0fd7c1179cd4fb995d2a5159c.
+//
+// Solidity: event OwnerChanged(address indexed oldOwner, address indexed newOwner)
+func (_Blockchain *BlockchainFilterer) FilterOwnerChanged(opts *bind.FilterOpts, oldOwner []common.Address, newOwner []common.Address) (*BlockchainOwnerChangedIterator, error) {
+
+	var oldOwnerRule []interface{}
+	for _, oldOwnerItem := range oldOwner {
+		oldOwnerRule = append(oldOwnerRule, oldOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Blockchain.contract.FilterLogs(opts, "OwnerChanged", oldOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainOwnerChangedIterator{contract: _Blockchain.contract, event: "OwnerChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnerChanged is a free log subscription operation binding the contract event 0xb532073b38c83145e3e5135377a08bf9aab55bc0fd7c1179cd4fb995d2a5159c.
+//
+// Solidity: event OwnerChanged(address indexed oldOwner, address indexed newOwner)
+func (_Blockchain *BlockchainFilterer) WatchOwnerChanged(opts *bind.WatchOpts, sink chan<- *BlockchainOwnerChanged, oldOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var oldOwnerRule []interface{}
+	for _, oldOwnerItem := range oldOwner {
+		oldOwnerRule = append(oldOwnerRule, oldOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Blockchain.contract.WatchLogs(opts, "OwnerChanged", oldOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BlockchainOwnerChanged)
+				if err := _Blockchain.contract.UnpackLog(event, "OwnerChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnerChanged is a log parse operation binding the contract event 0xb532073b38c83145e3e5135377a08bf9aab55bc0fd7c1179cd4fb995d2a5159c.
+//
+// Solidity: event OwnerChanged(address indexed oldOwner, address indexed newOwner)
+func (_Blockchain *BlockchainFilterer) ParseOwnerChanged(log types.Log) (*BlockchainOwnerChanged, error) {
+	event := new(BlockchainOwnerChanged)
+	if err := _Blockchain.contract.UnpackLog(event, "OwnerChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BlockchainPoolCreatedIterator is returned from FilterPoolCreated and is used to iterate over the raw logs and unpacked data for PoolCreated events raised by the Blockchain contract.
+type BlockchainPoolCreatedIterator struct {
+	Event *BlockchainPoolCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BlockchainPoolCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BlockchainPoolCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BlockchainPoolCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BlockchainPoolCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BlockchainPoolCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BlockchainPoolCreated represents a PoolCreated event raised by the Blockchain contract.
+type BlockchainPoolCreated struct {
+	Token0      common.Address
+	Token1      common.Address
+	Fee         *big.Int
+	TickSpacing *big.Int
+	Pool        common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPoolCreated is a free log retrieval operation binding the contract event 0x783cca1c0412dd0d695e784568c96da2e9c22ff989357a2e8b1d9b2b4e6b7118.
+//
+// Solidity: event PoolCreated(address indexed token0, address indexed token1, uint24 indexed fee, int24 tickSpacing, address pool)
+func (_Blockchain *BlockchainFilterer) FilterPoolCreated(opts *bind.FilterOpts, token0 []common.Address, token1 []common.Address, fee []*big.Int) (*BlockchainPoolCreatedIterator, error) {
+
+	var token0Rule []interface{}
+	for _, token0Item := range token0 {
+		token0Rule = append(token0Rule, token0Item)
+	}
+	var token1Rule []interface{}
+	for _, token1Item := range token1 {
+		token1Rule = append(token1Rule, token1Item)
+	}
+	var feeRule []interface{}
+	for _, feeItem := range fee {
+		feeRule = append(feeRule, feeItem)
+	}
+
+	logs, sub, err := _Blockchain.contract.FilterLogs(opts, "PoolCreated", token0Rule, token1Rule, feeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainPoolCreatedIterator{contract: _Blockchain.contract, event: "PoolCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchPoolCreated is a free log subscription operation binding the contract event 0x783cca1c0412dd0d695e784568c96da2e9c22ff989357a2e8b1d9b2b4e6b7118.
+//
+// Solidity: event PoolCreated(address indexed token0, address indexed token1, uint24 indexed fee, int24 tickSpacing, address pool)
+func (_Blockchain *BlockchainFilterer) WatchPoolCreated(opts *bind.WatchOpts, sink chan<- *BlockchainPoolCreated, token0 []common.Address, token1 []common.Address, fee []*big.Int) (event.Subscription, error) {
+
+	var token0Rule []interface{}
+	for _, token0Item := range token0 {
+		token0Rule = append(token0Rule, token0Item)
+	}
+	var token1Rule []interface{}
+	for _, token1Item := range token1 {
+		token1Rule = append(token1Rule, token1Item)
+	}
+	var feeRule []interface{}
+	for _, feeItem := range fee {
+		feeRule = append(feeRule, feeItem)
+	}
+
+	logs, sub, err := _Blockchain.contract.WatchLogs(opts, "PoolCreated", token0Rule, token1Rule, feeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BlockchainPoolCreated)
+				if err := _Blockchain.contract.UnpackLog(event, "PoolCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePoolCreated is a log parse operation binding the contract event 0x783cca1c0412dd0d695e784568c96da2e9c22ff989357a2e8b1d9b2b4e6b7118.
+//
+// Solidity: event PoolCreated(address indexed token0, address indexed token1, uint24 indexed fee, int24 tickSpacing, address pool)
+func (_Blockchain *BlockchainFilterer) ParsePoolCreated(log types.Log) (*BlockchainPoolCreated, error) {
+	event := new(BlockchainPoolCreated)
+	if err := _Blockchain.contract.UnpackLog(event, "PoolCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
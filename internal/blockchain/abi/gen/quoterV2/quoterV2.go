@@ -31,7 +31,7 @@ var (
 
 // QuoterV2MetaData contains all meta data concerning the QuoterV2 contract.
 var QuoterV2MetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"path\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"amountIn\",\"type\":\"uint256\"}],\"name\":\"quoteExactInput\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountOut\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"path\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"amountOut\",\"type\":\"uint256\"}],\"name\":\"quoteExactOutput\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountIn\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"path\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"amountIn\",\"type\":\"uint256\"}],\"name\":\"quoteExactInput\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountOut\",\"type\":\"uint256\"},{\"internalType\":\"uint160[]\",\"name\":\"sqrtPriceX96AfterList\",\"type\":\"uint160[]\"},{\"internalType\":\"uint32[]\",\"name\":\"initializedTicksCrossedList\",\"type\":\"uint32[]\"},{\"internalType\":\"uint256\",\"name\":\"gasEstimate\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"path\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"amountOut\",\"type\":\"uint256\"}],\"name\":\"quoteExactOutput\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountIn\",\"type\":\"uint256\"},{\"internalType\":\"uint160[]\",\"name\":\"sqrtPriceX96AfterList\",\"type\":\"uint160[]\"},{\"internalType\":\"uint32[]\",\"name\":\"initializedTicksCrossedList\",\"type\":\"uint32[]\"},{\"internalType\":\"uint256\",\"name\":\"gasEstimate\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"WETH9\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"factory\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
 }
 
 // QuoterV2ABI is the input ABI used to generate the binding from.
@@ -180,64 +180,174 @@ func (_QuoterV2 *QuoterV2TransactorRaw) Transact(opts *bind.TransactOpts, method
 	return _QuoterV2.Contract.contract.Transact(opts, method, params...)
 }
 
+// WETH9 is a free data retrieval call binding the contract method 0x4aa4a4fc.
+//
+// Solidity: function WETH9() view returns(address)
+func (_QuoterV2 *QuoterV2Caller) WETH9(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _QuoterV2.contract.Call(opts, &out, "WETH9")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// WETH9 is a free data retrieval call binding the contract method 0x4aa4a4fc.
+//
+// Solidity: function WETH9() view returns(address)
+func (_QuoterV2 *QuoterV2Session) WETH9() (common.Address, error) {
+	return _QuoterV2.Contract.WETH9(&_QuoterV2.CallOpts)
+}
+
+// WETH9 is a free data retrieval call binding the contract method 0x4aa4a4fc.
+//
+// Solidity: function WETH9() view returns(address)
+func (_QuoterV2 *QuoterV2CallerSession) WETH9() (common.Address, error) {
+	return _QuoterV2.Contract.WETH9(&_QuoterV2.CallOpts)
+}
+
+// Factory is a free data retrieval call binding the contract method 0xc45a0155.
+//
+// Solidity: function factory() view returns(address)
+func (_QuoterV2 *QuoterV2Caller) Factory(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _QuoterV2.contract.Call(opts, &out, "factory")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Factory is a free data retrieval call binding the contract method 0xc45a0155.
+//
+// Solidity: function factory() view returns(address)
+func (_QuoterV2 *QuoterV2Session) Factory() (common.Address, error) {
+	return _QuoterV2.Contract.Factory(&_QuoterV2.CallOpts)
+}
+
+// Factory is a free data retrieval call binding the contract method 0xc45a0155.
+//
+// Solidity: function factory() view returns(address)
+func (_QuoterV2 *QuoterV2CallerSession) Factory() (common.Address, error) {
+	return _QuoterV2.Contract.Factory(&_QuoterV2.CallOpts)
+}
+
 // QuoteExactInput is a free data retrieval call binding the contract method 0xcdca1753.
 //
-// Solidity: function quoteExactInput(bytes path, uint256 amountIn) view returns(uint256 amountOut)
-func (_QuoterV2 *QuoterV2Caller) QuoteExactInput(opts *bind.CallOpts, path []byte, amountIn *big.Int) (*big.Int, error) {
+// Solidity: function quoteExactInput(bytes path, uint256 amountIn) view returns(uint256 amountOut, uint160[] sqrtPriceX96AfterList, uint32[] initializedTicksCrossedList, uint256 gasEstimate)
+func (_QuoterV2 *QuoterV2Caller) QuoteExactInput(opts *bind.CallOpts, path []byte, amountIn *big.Int) (struct {
+	AmountOut                   *big.Int
+	SqrtPriceX96AfterList       []*big.Int
+	InitializedTicksCrossedList []uint32
+	GasEstimate                 *big.Int
+}, error) {
 	var out []interface{}
 	err := _QuoterV2.contract.Call(opts, &out, "quoteExactInput", path, amountIn)
 
+	outstruct := new(struct {
+		AmountOut                   *big.Int
+		SqrtPriceX96AfterList       []*big.Int
+		InitializedTicksCrossedList []uint32
+		GasEstimate                 *big.Int
+	})
 	if err != nil {
-		return *new(*big.Int), err
+		return *outstruct, err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.AmountOut = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.SqrtPriceX96AfterList = *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+	outstruct.InitializedTicksCrossedList = *abi.ConvertType(out[2], new([]uint32)).(*[]uint32)
+	outstruct.GasEstimate = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
 
-	return out0, err
+	return *outstruct, err
 
 }
 
 // QuoteExactInput is a free data retrieval call binding the contract method 0xcdca1753.
 //
-// Solidity: function quoteExactInput(bytes path, uint256 amountIn) view returns(uint256 amountOut)
-func (_QuoterV2 *QuoterV2Session) QuoteExactInput(path []byte, amountIn *big.Int) (*big.Int, error) {
+// Solidity: function quoteExactInput(bytes path, uint256 amountIn) view returns(uint256 amountOut, uint160[] sqrtPriceX96AfterList, uint32[] initializedTicksCrossedList, uint256 gasEstimate)
+func (_QuoterV2 *QuoterV2Session) QuoteExactInput(path []byte, amountIn *big.Int) (struct {
+	AmountOut                   *big.Int
+	SqrtPriceX96AfterList       []*big.Int
+	InitializedTicksCrossedList []uint32
+	GasEstimate                 *big.Int
+}, error) {
 	return _QuoterV2.Contract.QuoteExactInput(&_QuoterV2.CallOpts, path, amountIn)
 }
 
 // QuoteExactInput is a free data retrieval call binding the contract method 0xcdca1753.
 //
-// Solidity: function quoteExactInput(bytes path, uint256 amountIn) view returns(uint256 amountOut)
-func (_QuoterV2 *QuoterV2CallerSession) QuoteExactInput(path []byte, amountIn *big.Int) (*big.Int, error) {
+// Solidity: function quoteExactInput(bytes path, uint256 amountIn) view returns(uint256 amountOut, uint160[] sqrtPriceX96AfterList, uint32[] initializedTicksCrossedList, uint256 gasEstimate)
+func (_QuoterV2 *QuoterV2CallerSession) QuoteExactInput(path []byte, amountIn *big.Int) (struct {
+	AmountOut                   *big.Int
+	SqrtPriceX96AfterList       []*big.Int
+	InitializedTicksCrossedList []uint32
+	GasEstimate                 *big.Int
+}, error) {
 	return _QuoterV2.Contract.QuoteExactInput(&_QuoterV2.CallOpts, path, amountIn)
 }
 
 // QuoteExactOutput is a free data retrieval call binding the contract method 0x2f80bb1d.
 //
-// Solidity: function quoteExactOutput(bytes path, uint256 amountOut) view returns(uint256 amountIn)
-func (_QuoterV2 *QuoterV2Caller) QuoteExactOutput(opts *bind.CallOpts, path []byte, amountOut *big.Int) (*big.Int, error) {
+// Solidity: function quoteExactOutput(bytes path, uint256 amountOut) view returns(uint256 amountIn, uint160[] sqrtPriceX96AfterList, uint32[] initializedTicksCrossedList, uint256 gasEstimate)
+func (_QuoterV2 *QuoterV2Caller) QuoteExactOutput(opts *bind.CallOpts, path []byte, amountOut *big.Int) (struct {
+	AmountIn                    *big.Int
+	SqrtPriceX96AfterList       []*big.Int
+	InitializedTicksCrossedList []uint32
+	GasEstimate                 *big.Int
+}, error) {
 	var out []interface{}
 	err := _QuoterV2.contract.Call(opts, &out, "quoteExactOutput", path, amountOut)
 
+	outstruct := new(struct {
+		AmountIn                    *big.Int
+		SqrtPriceX96AfterList       []*big.Int
+		InitializedTicksCrossedList []uint32
+		GasEstimate                 *big.Int
+	})
 	if err != nil {
-		return *new(*big.Int), err
+		return *outstruct, err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.AmountIn = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.SqrtPriceX96AfterList = *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+	outstruct.InitializedTicksCrossedList = *abi.ConvertType(out[2], new([]uint32)).(*[]uint32)
+	outstruct.GasEstimate = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
 
-	return out0, err
+	return *outstruct, err
 
 }
 
 // QuoteExactOutput is a free data retrieval call binding the contract method 0x2f80bb1d.
 //
-// Solidity: function quoteExactOutput(bytes path, uint256 amountOut) view returns(uint256 amountIn)
-func (_QuoterV2 *QuoterV2Session) QuoteExactOutput(path []byte, amountOut *big.Int) (*big.Int, error) {
+// Solidity: function quoteExactOutput(bytes path, uint256 amountOut) view returns(uint256 amountIn, uint160[] sqrtPriceX96AfterList, uint32[] initializedTicksCrossedList, uint256 gasEstimate)
+func (_QuoterV2 *QuoterV2Session) QuoteExactOutput(path []byte, amountOut *big.Int) (struct {
+	AmountIn                    *big.Int
+	SqrtPriceX96AfterList       []*big.Int
+	InitializedTicksCrossedList []uint32
+	GasEstimate                 *big.Int
+}, error) {
 	return _QuoterV2.Contract.QuoteExactOutput(&_QuoterV2.CallOpts, path, amountOut)
 }
 
 // QuoteExactOutput is a free data retrieval call binding the contract method 0x2f80bb1d.
 //
-// Solidity: function quoteExactOutput(bytes path, uint256 amountOut) view returns(uint256 amountIn)
-func (_QuoterV2 *QuoterV2CallerSession) QuoteExactOutput(path []byte, amountOut *big.Int) (*big.Int, error) {
+// Solidity: function quoteExactOutput(bytes path, uint256 amountOut) view returns(uint256 amountIn, uint160[] sqrtPriceX96AfterList, uint32[] initializedTicksCrossedList, uint256 gasEstimate)
+func (_QuoterV2 *QuoterV2CallerSession) QuoteExactOutput(path []byte, amountOut *big.Int) (struct {
+	AmountIn                    *big.Int
+	SqrtPriceX96AfterList       []*big.Int
+	InitializedTicksCrossedList []uint32
+	GasEstimate                 *big.Int
+}, error) {
 	return _QuoterV2.Contract.QuoteExactOutput(&_QuoterV2.CallOpts, path, amountOut)
 }
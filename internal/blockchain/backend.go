@@ -0,0 +1,233 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sirupsen/logrus"
+)
+
+// Backend is the part of the Ethereum JSON-RPC API used by the service. It satisfies the
+// contract binding backends, so bindings keep working when the client fails over.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	bind.BlockHashContractCaller
+
+	ChainID(ctx context.Context) (*big.Int, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+// backend implements Backend on top of a Client's endpoints.
+type backend struct {
+	client *Client
+}
+
+// call runs fn against the best endpoint and retries on the next best one when the error comes
+// from the transport rather than from the node, e.g. a reverted call is not retried.
+// Every attempt waits for the chain's rate limiter.
+func call[T any](ctx context.Context, c *Client, method string, fn func(*ethclient.Client) (T, error)) (T, error) {
+	var (
+		zero    T
+		lastErr = ErrNoEndpoint
+		tried   = make(map[*endpoint]bool)
+	)
+
+	for {
+		ep := c.pick(tried)
+		if ep == nil {
+			return zero, lastErr
+		}
+
+		c.mu.RLock()
+		client := ep.client
+		c.mu.RUnlock()
+		if client == nil {
+			tried[ep] = true
+			continue
+		}
+
+		if err := c.throttle(ctx); err != nil {
+			return zero, err
+		}
+
+		start := time.Now()
+		result, err := fn(client)
+		if err == nil || !retryable(ctx, err) {
+			c.record(ep, time.Since(start), nil)
+			return result, err
+		}
+
+		c.record(ep, time.Since(start), err)
+		tried[ep] = true
+		lastErr = err
+		logrus.Warnf("%s call %s failed on %s, failing over: %v", c.Chain, method, redact(ep.url), err)
+	}
+}
+
+// retryable reports whether err is worth retrying on another endpoint. Errors returned by the
+// node itself, missing results and cancelled contexts would fail the same way everywhere.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return !errors.Is(err, ethereum.NotFound) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+func (b *backend) ChainID(ctx context.Context) (*big.Int, error) {
+	return coalesce(ctx, b.client, "eth_chainId", func(ctx context.Context) (*big.Int, error) {
+		return call(ctx, b.client, "ChainID", func(c *ethclient.Client) (*big.Int, error) {
+			return c.ChainID(ctx)
+		})
+	})
+}
+
+func (b *backend) BlockNumber(ctx context.Context) (uint64, error) {
+	return coalesce(ctx, b.client, "eth_blockNumber", func(ctx context.Context) (uint64, error) {
+		return call(ctx, b.client, "BlockNumber", func(c *ethclient.Client) (uint64, error) {
+			return c.BlockNumber(ctx)
+		})
+	})
+}
+
+func (b *backend) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return call(ctx, b.client, "BalanceAt", func(c *ethclient.Client) (*big.Int, error) {
+		return c.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+func (b *backend) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return call(ctx, b.client, "HeaderByHash", func(c *ethclient.Client) (*types.Header, error) {
+		return c.HeaderByHash(ctx, hash)
+	})
+}
+
+func (b *backend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return coalesce(ctx, b.client, fmt.Sprintf("eth_getBlockByNumber|%v", number), func(ctx context.Context) (*types.Header, error) {
+		return call(ctx, b.client, "HeaderByNumber", func(c *ethclient.Client) (*types.Header, error) {
+			return c.HeaderByNumber(ctx, number)
+		})
+	})
+}
+
+func (b *backend) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	type txResult struct {
+		tx      *types.Transaction
+		pending bool
+	}
+
+	result, err := call(ctx, b.client, "TransactionByHash", func(c *ethclient.Client) (txResult, error) {
+		tx, pending, err := c.TransactionByHash(ctx, hash)
+		return txResult{tx: tx, pending: pending}, err
+	})
+
+	return result.tx, result.pending, err
+}
+
+func (b *backend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return call(ctx, b.client, "TransactionReceipt", func(c *ethclient.Client) (*types.Receipt, error) {
+		return c.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (b *backend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, b.client, "CodeAt", func(c *ethclient.Client) ([]byte, error) {
+		return c.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+// CallContract sends identical concurrent calls upstream once, callers must not modify the result.
+func (b *backend) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return coalesce(ctx, b.client, callKey(msg, blockNumber), func(ctx context.Context) ([]byte, error) {
+		return call(ctx, b.client, "CallContract", func(c *ethclient.Client) ([]byte, error) {
+			return c.CallContract(ctx, msg, blockNumber)
+		})
+	})
+}
+
+func (b *backend) CodeAtHash(ctx context.Context, contract common.Address, blockHash common.Hash) ([]byte, error) {
+	return call(ctx, b.client, "CodeAtHash", func(c *ethclient.Client) ([]byte, error) {
+		return c.CodeAtHash(ctx, contract, blockHash)
+	})
+}
+
+func (b *backend) CallContractAtHash(ctx context.Context, msg ethereum.CallMsg, blockHash common.Hash) ([]byte, error) {
+	return coalesce(ctx, b.client, callKey(msg, blockHash.Hex()), func(ctx context.Context) ([]byte, error) {
+		return call(ctx, b.client, "CallContractAtHash", func(c *ethclient.Client) ([]byte, error) {
+			return c.CallContractAtHash(ctx, msg, blockHash)
+		})
+	})
+}
+
+func (b *backend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return call(ctx, b.client, "PendingCodeAt", func(c *ethclient.Client) ([]byte, error) {
+		return c.PendingCodeAt(ctx, account)
+	})
+}
+
+func (b *backend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return call(ctx, b.client, "PendingNonceAt", func(c *ethclient.Client) (uint64, error) {
+		return c.PendingNonceAt(ctx, account)
+	})
+}
+
+func (b *backend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return call(ctx, b.client, "SuggestGasPrice", func(c *ethclient.Client) (*big.Int, error) {
+		return c.SuggestGasPrice(ctx)
+	})
+}
+
+func (b *backend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return call(ctx, b.client, "SuggestGasTipCap", func(c *ethclient.Client) (*big.Int, error) {
+		return c.SuggestGasTipCap(ctx)
+	})
+}
+
+func (b *backend) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return call(ctx, b.client, "EstimateGas", func(c *ethclient.Client) (uint64, error) {
+		return c.EstimateGas(ctx, msg)
+	})
+}
+
+// SendTransaction may be retried on another endpoint: the signed transaction has the same hash
+// everywhere, so a duplicate submission is rejected as known rather than executed twice.
+func (b *backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	_, err := call(ctx, b.client, "SendTransaction", func(c *ethclient.Client) (struct{}, error) {
+		return struct{}{}, c.SendTransaction(ctx, tx)
+	})
+	if err != nil && strings.Contains(err.Error(), "already known") {
+		return nil
+	}
+	return err
+}
+
+func (b *backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return call(ctx, b.client, "FilterLogs", func(c *ethclient.Client) ([]types.Log, error) {
+		return c.FilterLogs(ctx, query)
+	})
+}
+
+func (b *backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return call(ctx, b.client, "SubscribeFilterLogs", func(c *ethclient.Client) (ethereum.Subscription, error) {
+		return c.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
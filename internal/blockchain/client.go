@@ -3,56 +3,335 @@ package blockchain
 import (
 	"context"
 	"errors"
+	"expvar"
 	"fmt"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/joho/godotenv"
+	"github.com/sirupsen/logrus"
 
 	"github.com/ethereum/go-ethereum/ethclient"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+	"grpc_cake/internal/registry"
 )
 
 const (
-	ChainBSC  = "bsc"
-	ChainETH  = "eth"
-	ChainBase = "base"
+	// dialTimeout bounds dialing and verifying a single endpoint.
+	dialTimeout = 15 * time.Second
+	// healthInterval is how often every endpoint is probed, and disconnected ones re-dialed.
+	healthInterval = 10 * time.Second
+	// maxBlockLag is how many blocks an endpoint may trail the best one before it is considered stale.
+	maxBlockLag = 5
+	// maxErrorRate is the smoothed error rate above which an endpoint is only used as a last resort.
+	maxErrorRate = 0.5
+	// redialAfter is the number of consecutive failed probes after which an endpoint is re-dialed.
+	redialAfter = 3
+	// ewmaWeight is the weight of the newest sample in the latency and error rate averages.
+	ewmaWeight = 0.2
 )
 
-var rpcURLs = map[string]string{
-	ChainBSC:  os.Getenv("CHAIN_BSC"),
-	ChainETH:  os.Getenv("CHAIN_ETH"),
-	ChainBase: os.Getenv("CHAIN_BASE"),
-}
+var ErrNoEndpoint = errors.New("no healthy RPC endpoint")
 
+// Client is a chain client over one or more RPC endpoints. Calls go to the healthiest endpoint
+// and fail over to the next one on transport errors. A background loop probes every endpoint
+// for block height, latency and errors, and re-dials the ones that are down.
+// Calls share the chain's rate limit, and identical reads in flight are sent upstream once.
 type Client struct {
-	client *ethclient.Client
 	Chain  string
+	Config registry.Chain
+
+	backend  *backend
+	stop     chan struct{}
+	stopOnce sync.Once
+
+	limiter  *rate.Limiter
+	inflight singleflight.Group
+	metrics  *expvar.Map
+
+	head atomic.Pointer[Block]
+
+	mu            sync.RWMutex
+	endpoints     []*endpoint
+	headListeners []func(head Block)
 }
 
-func (c *Client) Eth() *ethclient.Client {
-	return c.client
+type endpoint struct {
+	url    string
+	client *ethclient.Client
+
+	head      uint64
+	latency   time.Duration
+	errorRate float64
+	failures  int
+	lastErr   error
 }
 
-func NewClient(chain string) (*Client, error) {
-	godotenv.Load()
+// EndpointStatus is a snapshot of an endpoint's health.
+type EndpointStatus struct {
+	URL       string
+	Connected bool
+	Head      uint64
+	Latency   time.Duration
+	ErrorRate float64
+	LastError error
+}
 
-	rpcURL, ok := rpcURLs[chain]
-	if !ok {
-		return nil, errors.New(fmt.Sprintf("unsupported chain: %s", chain))
+// Eth returns the backend used for all chain calls, it fails over between the client's endpoints.
+func (c *Client) Eth() Backend {
+	return c.backend
+}
+
+// NewClient creates a client for the chain's configured RPC endpoints. API_KEY is appended to
+// every endpoint ending with a slash. The client is returned even if no endpoint can be dialed
+// yet, the health loop keeps re-dialing them in the background.
+func NewClient(config registry.Chain) (*Client, error) {
+	var endpoints []*endpoint
+	for _, rpcURL := range config.RPC {
+		if strings.HasSuffix(rpcURL, "/") {
+			rpcURL = fmt.Sprintf("%s%s", rpcURL, os.Getenv("API_KEY"))
+		}
+		endpoints = append(endpoints, &endpoint{url: rpcURL})
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no RPC endpoints configured for chain %s", config.Name)
+	}
+
+	c := &Client{
+		Chain:     config.Name,
+		Config:    config,
+		endpoints: endpoints,
+		stop:      make(chan struct{}),
+		limiter:   newLimiter(config.RateLimit, config.RateBurst),
+		metrics:   newMetrics(config.Name),
+	}
+	c.backend = &backend{client: c}
+
+	c.probe()
+	if !c.Ready() {
+		logrus.Warnf("no RPC endpoint of %s is reachable yet, reconnecting in background", config.Name)
+	}
+
+	go c.healthLoop()
+	go c.headLoop()
+
+	return c, nil
+}
+
+// Ready reports whether at least one endpoint is connected.
+func (c *Client) Ready() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, ep := range c.endpoints {
+		if ep.client != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Endpoints returns the health of every endpoint, with URLs stripped of their query and API key path.
+func (c *Client) Endpoints() []EndpointStatus {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	statuses := make([]EndpointStatus, 0, len(c.endpoints))
+	for _, ep := range c.endpoints {
+		statuses = append(statuses, EndpointStatus{
+			URL:       redact(ep.url),
+			Connected: ep.client != nil,
+			Head:      ep.head,
+			Latency:   ep.latency,
+			ErrorRate: ep.errorRate,
+			LastError: ep.lastErr,
+		})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
+	return statuses
+}
+
+// Close stops the health and head loops and closes all endpoint connections. Later calls do nothing.
+func (c *Client) Close() {
+	c.stopOnce.Do(func() { close(c.stop) })
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, ep := range c.endpoints {
+		if ep.client != nil {
+			ep.client.Close()
+			ep.client = nil
+		}
+	}
+}
+
+func (c *Client) healthLoop() {
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.probe()
+		}
+	}
+}
 
-	rpcURL = fmt.Sprintf("%s%s", rpcURL, os.Getenv("API_KEY"))
-	rawClient, err := ethclient.DialContext(ctx, rpcURL)
+// probe dials disconnected endpoints and measures block height and latency of connected ones.
+func (c *Client) probe() {
+	var wg sync.WaitGroup
 
+	c.mu.RLock()
+	endpoints := append([]*endpoint(nil), c.endpoints...)
+	c.mu.RUnlock()
+
+	for _, ep := range endpoints {
+		wg.Add(1)
+		go func(ep *endpoint) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+			defer cancel()
+
+			c.mu.RLock()
+			client := ep.client
+			c.mu.RUnlock()
+
+			if client == nil {
+				if err := c.dial(ctx, ep); err != nil {
+					logrus.Warnf("failed to dial %s endpoint %s: %v", c.Chain, redact(ep.url), err)
+					return
+				}
+				c.mu.RLock()
+				client = ep.client
+				c.mu.RUnlock()
+			}
+
+			start := time.Now()
+			head, err := client.BlockNumber(ctx)
+			c.record(ep, time.Since(start), err)
+
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if err == nil {
+				ep.head = head
+				return
+			}
+			if ep.failures >= redialAfter && ep.client != nil {
+				logrus.Warnf("%s endpoint %s failed %d probes, re-dialing: %v", c.Chain, redact(ep.url), ep.failures, err)
+				ep.client.Close()
+				ep.client = nil
+			}
+		}(ep)
+	}
+
+	wg.Wait()
+}
+
+// dial connects ep and checks that it serves the chain ID configured for the chain.
+func (c *Client) dial(ctx context.Context, ep *endpoint) error {
+	client, err := ethclient.DialContext(ctx, ep.url)
 	if err != nil {
-		return nil, err
+		c.record(ep, 0, err)
+		return err
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		c.record(ep, 0, err)
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !chainID.IsUint64() || chainID.Uint64() != c.Config.ChainID {
+		client.Close()
+		ep.lastErr = fmt.Errorf("%w: endpoint serves chain ID %s, expected %d", ErrMisconfigured, chainID, c.Config.ChainID)
+		return ep.lastErr
+	}
+
+	ep.client = client
+	ep.failures = 0
+
+	return nil
+}
+
+// record folds the outcome of a call into the endpoint's latency and error rate.
+func (c *Client) record(ep *endpoint, latency time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sample := 0.0
+	if err != nil {
+		sample = 1
+		ep.failures++
+		ep.lastErr = err
+	} else {
+		ep.failures = 0
+		if ep.latency == 0 {
+			ep.latency = latency
+		} else {
+			ep.latency = time.Duration((1-ewmaWeight)*float64(ep.latency) + ewmaWeight*float64(latency))
+		}
+	}
+	ep.errorRate = (1-ewmaWeight)*ep.errorRate + ewmaWeight*sample
+}
+
+// pick returns the best connected endpoint not in skip: endpoints within maxBlockLag of the best
+// head and under maxErrorRate come first, then the lowest latency wins.
+func (c *Client) pick(skip map[*endpoint]bool) *endpoint {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var best uint64
+	for _, ep := range c.endpoints {
+		if ep.client != nil && ep.head > best {
+			best = ep.head
+		}
+	}
+
+	healthy := func(ep *endpoint) bool {
+		return best-ep.head <= maxBlockLag && ep.errorRate <= maxErrorRate
+	}
+
+	var chosen *endpoint
+	for _, ep := range c.endpoints {
+		if ep.client == nil || skip[ep] {
+			continue
+		}
+		if chosen == nil {
+			chosen = ep
+			continue
+		}
+
+		switch epHealthy, chosenHealthy := healthy(ep), healthy(chosen); {
+		case epHealthy && !chosenHealthy:
+			chosen = ep
+		case epHealthy == chosenHealthy && ep.latency < chosen.latency:
+			chosen = ep
+		}
+	}
+
+	return chosen
+}
+
+// redact drops the path and query of an endpoint URL, which usually carry the API key.
+func redact(rpcURL string) string {
+	scheme, rest, ok := strings.Cut(rpcURL, "://")
+	if !ok {
+		return rpcURL
 	}
+	host, _, _ := strings.Cut(rest, "/")
+	host, _, _ = strings.Cut(host, "?")
 
-	return &Client{
-		client: rawClient,
-		Chain:  chain,
-	}, nil
+	return scheme + "://" + host
 }
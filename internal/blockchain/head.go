@@ -0,0 +1,143 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+)
+
+// headInterval is how often the latest block header is polled.
+const headInterval = time.Second
+
+// Block identifies a block by number and hash.
+type Block struct {
+	Number uint64
+	Hash   common.Hash
+	// Time is the block's Unix timestamp.
+	Time uint64
+}
+
+// Head returns the latest block seen by the client, or a zero Block before the first one.
+func (c *Client) Head() Block {
+	if head := c.head.Load(); head != nil {
+		return *head
+	}
+
+	return Block{}
+}
+
+// OnNewHead registers fn to be called with every new head, in order and from a single goroutine.
+// fn holds up the other listeners until it returns, so slow work belongs on its own goroutine.
+func (c *Client) OnNewHead(fn func(head Block)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.headListeners = append(c.headListeners, fn)
+}
+
+// BlockAt returns the latest block with a timestamp at or before timestamp. The search gallops back
+// from the head and bisects, reading only headers, so any node serves it.
+func (c *Client) BlockAt(ctx context.Context, timestamp uint64) (Block, error) {
+	head := c.Head()
+	if head.Number == 0 {
+		header, err := c.Eth().HeaderByNumber(ctx, nil)
+		if err != nil {
+			return Block{}, err
+		}
+		head = Block{Number: header.Number.Uint64(), Hash: header.Hash(), Time: header.Time}
+	}
+	if head.Time <= timestamp {
+		return head, nil
+	}
+
+	// after is known to be later than timestamp, before is known not to be once found.
+	var (
+		after  = head
+		before Block
+		found  bool
+	)
+	for back := uint64(1); !found; back *= 2 {
+		if back >= head.Number {
+			back = head.Number
+		}
+
+		block, err := c.blockByNumber(ctx, head.Number-back)
+		if err != nil {
+			return Block{}, err
+		}
+		if block.Time > timestamp {
+			if block.Number == 0 {
+				return Block{}, fmt.Errorf("no %s block at or before %d", c.Chain, timestamp)
+			}
+			after = block
+			continue
+		}
+		before, found = block, true
+	}
+
+	for after.Number-before.Number > 1 {
+		block, err := c.blockByNumber(ctx, before.Number+(after.Number-before.Number)/2)
+		if err != nil {
+			return Block{}, err
+		}
+		if block.Time > timestamp {
+			after = block
+		} else {
+			before = block
+		}
+	}
+
+	return before, nil
+}
+
+func (c *Client) blockByNumber(ctx context.Context, number uint64) (Block, error) {
+	header, err := c.Eth().HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return Block{}, err
+	}
+
+	return Block{Number: number, Hash: header.Hash(), Time: header.Time}, nil
+}
+
+func (c *Client) headLoop() {
+	ticker := time.NewTicker(headInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+		}
+
+		if !c.Ready() {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), headInterval)
+		header, err := c.Eth().HeaderByNumber(ctx, nil)
+		cancel()
+		if err != nil {
+			logrus.Debugf("failed to poll %s head: %v", c.Chain, err)
+			continue
+		}
+
+		head := Block{Number: header.Number.Uint64(), Hash: header.Hash(), Time: header.Time}
+		if head.Number <= c.Head().Number {
+			continue
+		}
+		c.head.Store(&head)
+
+		c.mu.RLock()
+		listeners := append([]func(head Block){}, c.headListeners...)
+		c.mu.RUnlock()
+
+		for _, fn := range listeners {
+			fn(head)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package blockchain
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"golang.org/x/time/rate"
+)
+
+// coalesceTimeout bounds a call shared by several callers, it no longer follows the context of
+// the caller that started it.
+const coalesceTimeout = 30 * time.Second
+
+// rpcMetrics publishes per-chain RPC counters through expvar under "rpc":
+//
+//	requests                upstream requests sent, failover retries included
+//	throttled               requests that had to wait for the rate limiter
+//	throttle_wait_seconds   total time spent waiting for the rate limiter
+//	coalesced               calls answered by an identical call already in flight
+var rpcMetrics = expvar.NewMap("rpc")
+
+func newMetrics(chain string) *expvar.Map {
+	metrics := new(expvar.Map).Init()
+	rpcMetrics.Set(chain, metrics)
+
+	return metrics
+}
+
+func newLimiter(rps float64, burst int) *rate.Limiter {
+	if rps <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+
+	return rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+// throttle takes a token from the chain's bucket, waiting for one if the bucket is empty.
+func (c *Client) throttle(ctx context.Context) error {
+	c.metrics.Add("requests", 1)
+
+	if c.limiter.Allow() {
+		return nil
+	}
+
+	c.metrics.Add("throttled", 1)
+	start := time.Now()
+	err := c.limiter.Wait(ctx)
+	c.metrics.AddFloat("throttle_wait_seconds", time.Since(start).Seconds())
+
+	return err
+}
+
+// coalesce runs fn once for all concurrent callers with the same key and hands each of them the
+// result. Every caller still returns as soon as its own context is done.
+func coalesce[T any](ctx context.Context, c *Client, key string, fn func(ctx context.Context) (T, error)) (T, error) {
+	leader := false
+	ch := c.inflight.DoChan(key, func() (any, error) {
+		leader = true
+		shared, cancel := context.WithTimeout(context.WithoutCancel(ctx), coalesceTimeout)
+		defer cancel()
+
+		return fn(shared)
+	})
+
+	select {
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	case result := <-ch:
+		if !leader {
+			c.metrics.Add("coalesced", 1)
+		}
+		if result.Err != nil {
+			var zero T
+			return zero, result.Err
+		}
+		return result.Val.(T), nil
+	}
+}
+
+// callKey identifies an eth_call by everything that can change its result, block is the number or hash it runs at.
+func callKey(msg ethereum.CallMsg, block any) string {
+	var to string
+	if msg.To != nil {
+		to = msg.To.Hex()
+	}
+
+	return fmt.Sprintf("eth_call|%s|%s|%x|%d|%v|%v|%v|%v|%v",
+		msg.From.Hex(), to, msg.Data, msg.Gas, msg.GasPrice, msg.GasFeeCap, msg.GasTipCap, msg.Value, block)
+}
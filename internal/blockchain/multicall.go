@@ -0,0 +1,79 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/internal/blockchain/abi/gen/multicall3"
+)
+
+// Multicall3Address is the canonical Multicall3 deployment, the same on most chains.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// Multicall3Address returns the Multicall3 deployment of the client's chain.
+func (c *Client) Multicall3Address() common.Address {
+	if c.Config.Multicall3 != (common.Address{}) {
+		return c.Config.Multicall3
+	}
+
+	return Multicall3Address
+}
+
+// maxMulticallBatch caps the number of calls sent in one aggregate3 request,
+// so large batches stay under provider gas and payload limits.
+const maxMulticallBatch = 200
+
+// Call is a single contract call to be aggregated through Multicall3.
+type Call struct {
+	Target   common.Address
+	CallData []byte
+}
+
+// CallResult is the outcome of a single aggregated call. A failed call does not
+// fail the whole batch, Success is false and ReturnData holds the revert data.
+type CallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// Multicall executes calls through Multicall3 aggregate3 with per-call failure
+// tolerance and returns the results in the order of calls.
+func (c *Client) Multicall(ctx context.Context, opts *bind.CallOpts, calls []Call) ([]CallResult, error) {
+	if opts == nil {
+		opts = &bind.CallOpts{Context: ctx}
+	}
+
+	multicall, err := multicall3.NewBlockchainCaller(c.Multicall3Address(), c.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]CallResult, 0, len(calls))
+	for start := 0; start < len(calls); start += maxMulticallBatch {
+		end := min(start+maxMulticallBatch, len(calls))
+
+		batch := make([]multicall3.Multicall3Call3, 0, end-start)
+		for _, call := range calls[start:end] {
+			batch = append(batch, multicall3.Multicall3Call3{
+				Target:       call.Target,
+				AllowFailure: true,
+				CallData:     call.CallData,
+			})
+		}
+
+		out, err := multicall.Aggregate3(opts, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range out {
+			results = append(results, CallResult{
+				Success:    result.Success,
+				ReturnData: result.ReturnData,
+			})
+		}
+	}
+
+	return results, nil
+}
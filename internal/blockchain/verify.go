@@ -0,0 +1,51 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrMisconfigured marks a chain whose endpoints or contracts do not match the registry.
+// Unlike connectivity errors, retrying does not help.
+var ErrMisconfigured = errors.New("chain wiring is inconsistent with the registry")
+
+// VerifyChainID checks that the client talks to the chain ID configured for its chain.
+func (c *Client) VerifyChainID(ctx context.Context) error {
+	chainID, err := c.Eth().ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !chainID.IsUint64() || chainID.Uint64() != c.Config.ChainID {
+		return fmt.Errorf("%w: %s RPC serves chain ID %s, expected %d", ErrMisconfigured, c.Chain, chainID, c.Config.ChainID)
+	}
+
+	return nil
+}
+
+// VerifyCode checks that a contract is deployed at address.
+func (c *Client) VerifyCode(ctx context.Context, name string, address common.Address) error {
+	code, err := c.Eth().CodeAt(ctx, address, nil)
+	if err != nil {
+		return err
+	}
+
+	if len(code) == 0 {
+		return fmt.Errorf("%w: no %s deployed on %s at %s", ErrMisconfigured, name, c.Chain, address.Hex())
+	}
+
+	return nil
+}
+
+// ExpectAddress compares an address reported by a contract with the configured one.
+// A zero want means the registry does not pin it.
+func (c *Client) ExpectAddress(what string, got, want common.Address) error {
+	if want == (common.Address{}) || got == want {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s on %s is %s, expected %s", ErrMisconfigured, what, c.Chain, got.Hex(), want.Hex())
+}
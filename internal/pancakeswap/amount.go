@@ -0,0 +1,43 @@
+package pancakeswap
+
+import (
+	"fmt"
+	"math/big"
+
+	"grpc_cake/gen/go/quoteswap"
+)
+
+// AmountIn returns the base-unit input amount of a quote request. The decimal string
+// amount_in takes precedence over the deprecated uint64 amount field.
+func AmountIn(req *quoteswap.GetQuoteRequest) (*big.Int, error) {
+	if req.GetAmountIn() != "" {
+		return ParseAmount(req.GetAmountIn())
+	}
+
+	// amount is deprecated but still honoured during the deprecation window.
+	return new(big.Int).SetUint64(req.GetAmount()), nil
+}
+
+// ParseAmount parses a base-unit amount given as a decimal string and checks it fits into uint256.
+func ParseAmount(amount string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %q", amount)
+	}
+	if value.Sign() < 0 || value.BitLen() > 256 {
+		return nil, fmt.Errorf("amount %s is out of uint256 range", amount)
+	}
+
+	return value, nil
+}
+
+// Float64 approximates a base-unit amount for the deprecated double fields of the API.
+// Unlike big.Int.Int64 it never wraps around, large values only lose precision.
+func Float64(amount *big.Int) float64 {
+	if amount == nil {
+		return 0
+	}
+
+	value, _ := new(big.Float).SetInt(amount).Float64()
+	return value
+}
@@ -0,0 +1,25 @@
+package pancakeswap
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/gen/go/quoteswap"
+)
+
+// CallOpts returns the call options of a quote request, pinned to its block hash or block number
+// when one is given and against the latest block otherwise.
+func CallOpts(ctx context.Context, req *quoteswap.GetQuoteRequest) *bind.CallOpts {
+	opts := &bind.CallOpts{Context: ctx}
+
+	switch {
+	case req.GetBlockHash() != "":
+		opts.BlockHash = common.HexToHash(req.GetBlockHash())
+	case req.GetBlockNumber() != 0:
+		opts.BlockNumber = new(big.Int).SetUint64(req.GetBlockNumber())
+	}
+
+	return opts
+}
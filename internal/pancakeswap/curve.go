@@ -0,0 +1,23 @@
+package pancakeswap
+
+import (
+	"context"
+	"math/big"
+
+	"grpc_cake/gen/go/quoteswap"
+)
+
+// Curve is the output of one pool for increasing input amounts.
+type Curve struct {
+	Pool Pool
+	// AmountsOut holds the output for each requested input amount, nil where the pool cannot swap it.
+	AmountsOut []*big.Int
+}
+
+// CurveQuoter is implemented by swappers that can quote each of their pools separately, so an
+// order can be split across pools.
+type CurveQuoter interface {
+	// QuoteCurves quotes amountsIn of req's tokens through every pool of the pair at req's block.
+	// req's amount is ignored, a pair without pools yields no curve and no error.
+	QuoteCurves(ctx context.Context, req *quoteswap.GetQuoteRequest, amountsIn []*big.Int) ([]Curve, error)
+}
@@ -0,0 +1,75 @@
+package pancakeswap
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	erc20 "grpc_cake/internal/blockchain/abi/gen/erc20"
+	"grpc_cake/internal/blockchain/abi/gen/pairV2"
+	"grpc_cake/internal/blockchain/abi/gen/poolV3"
+)
+
+// Fill is what a mined swap actually did, as reported by its receipt logs.
+type Fill struct {
+	AmountIn    *big.Int
+	AmountOut   *big.Int
+	GasUsed     uint64
+	GasPaid     *big.Int
+	BlockNumber uint64
+	// Pools holds the V2 pairs and V3 pools that emitted a Swap event, in log order.
+	Pools []common.Address
+}
+
+var (
+	erc20Filterer, _ = erc20.NewBlockchainFilterer(common.Address{}, nil)
+	pairFilterer, _  = pairV2.NewBlockchainFilterer(common.Address{}, nil)
+	poolFilterer, _  = poolV3.NewBlockchainFilterer(common.Address{}, nil)
+)
+
+// ParseFill reads the amounts of a swap from its receipt. The amount in is the sum of tokenIn
+// transfers sent by sender, the amount out the sum of tokenOut transfers received by recipient.
+func ParseFill(receipt *types.Receipt, tokenIn, tokenOut, sender, recipient common.Address) *Fill {
+	fill := &Fill{
+		AmountIn:    new(big.Int),
+		AmountOut:   new(big.Int),
+		GasUsed:     receipt.GasUsed,
+		GasPaid:     new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), effectiveGasPrice(receipt)),
+		BlockNumber: receipt.BlockNumber.Uint64(),
+	}
+
+	for _, log := range receipt.Logs {
+		if log == nil {
+			continue
+		}
+
+		if transfer, err := erc20Filterer.ParseTransfer(*log); err == nil {
+			switch {
+			case log.Address == tokenIn && transfer.From == sender:
+				fill.AmountIn.Add(fill.AmountIn, transfer.Value)
+			case log.Address == tokenOut && transfer.To == recipient:
+				fill.AmountOut.Add(fill.AmountOut, transfer.Value)
+			}
+			continue
+		}
+
+		if _, err := pairFilterer.ParseSwap(*log); err == nil {
+			fill.Pools = append(fill.Pools, log.Address)
+			continue
+		}
+
+		if _, err := poolFilterer.ParseSwap(*log); err == nil {
+			fill.Pools = append(fill.Pools, log.Address)
+		}
+	}
+
+	return fill
+}
+
+func effectiveGasPrice(receipt *types.Receipt) *big.Int {
+	if receipt.EffectiveGasPrice == nil {
+		return new(big.Int)
+	}
+
+	return receipt.EffectiveGasPrice
+}
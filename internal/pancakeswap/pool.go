@@ -0,0 +1,41 @@
+package pancakeswap
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/gen/go/quoteswap"
+)
+
+// Pool is the state of a PancakeSwap pool of a token pair.
+type Pool struct {
+	Dex     string
+	Address common.Address
+	// Fee is the swap fee in hundredths of a bip, e.g. 2500 is 0.25%.
+	Fee    uint32
+	Token0 common.Address
+	Token1 common.Address
+
+	// Reserve0 and Reserve1 are the reserves of a V2 pair.
+	Reserve0 *big.Int
+	Reserve1 *big.Int
+
+	// Liquidity, SqrtPriceX96 and Tick are the in-range state of a V3 pool.
+	Liquidity    *big.Int
+	SqrtPriceX96 *big.Int
+	Tick         int32
+}
+
+// PoolLister is implemented by swappers that can discover pools through their factory.
+type PoolLister interface {
+	// Pools returns every existing pool of the pair, a pair without pools yields no error.
+	Pools(ctx context.Context, tokenA, tokenB common.Address) ([]Pool, error)
+}
+
+// PoolQuoter is implemented by swappers that can report the pool a quote went through.
+type PoolQuoter interface {
+	// QuotePool returns the pool of req's tokens and fee at req's block, as it was before the swap.
+	// A pool that does not exist yields no pool and no error.
+	QuotePool(ctx context.Context, req *quoteswap.GetQuoteRequest, fee uint32) (*Pool, error)
+}
@@ -0,0 +1,343 @@
+package smart
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+	erc20 "grpc_cake/internal/blockchain/abi/gen/erc20"
+	"grpc_cake/internal/blockchain/abi/gen/smartRouter"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/registry"
+)
+
+// Smart splits orders across the V2 and V3 pools of a chain and swaps every leg atomically in one
+// SmartRouter multicall.
+type Smart struct {
+	verification  pancakeswap.Verification
+	dex           registry.Dex
+	router        *smartRouter.Blockchain
+	routerABI     *abi.ABI
+	routerAddress common.Address
+	client        *blockchain.Client
+	// quoters quote the pools of the chain's other deployments.
+	quoters []pancakeswap.CurveQuoter
+}
+
+func NewSmart(client *blockchain.Client, dex registry.Dex, quoters []pancakeswap.CurveQuoter) (*Smart, error) {
+	router, err := smartRouter.NewBlockchain(dex.Router, client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	routerABI, err := smartRouter.BlockchainMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Smart{
+		dex:           dex,
+		router:        router,
+		routerABI:     routerABI,
+		routerAddress: dex.Router,
+		client:        client,
+		quoters:       quoters,
+	}, nil
+}
+
+// Verify checks the SmartRouter wiring against the registry once it succeeds, later calls return immediately.
+func (s *Smart) Verify(ctx context.Context) error {
+	return s.verification.Ensure(ctx, s.verify)
+}
+
+// verify checks that the client serves the configured chain and that the router is deployed and
+// wired to the chain's V2 and V3 factories and wrapped native token, so its legs swap through the
+// pools they were quoted on.
+func (s *Smart) verify(ctx context.Context) error {
+	if err := s.client.VerifyChainID(ctx); err != nil {
+		return err
+	}
+
+	if err := s.client.VerifyCode(ctx, "smart router", s.routerAddress); err != nil {
+		return err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	if dex, ok := s.client.Config.Dex(registry.DexV2); ok && dex.Factory != (common.Address{}) {
+		factory, err := s.router.FactoryV2(callOpts)
+		if err != nil {
+			return err
+		}
+		if err := s.client.ExpectAddress("smart router factoryV2()", factory, dex.Factory); err != nil {
+			return err
+		}
+	}
+
+	if dex, ok := s.client.Config.Dex(registry.DexV3); ok {
+		factory, err := s.router.Factory(callOpts)
+		if err != nil {
+			return err
+		}
+		if err := s.client.ExpectAddress("smart router factory()", factory, dex.Factory); err != nil {
+			return err
+		}
+	}
+
+	weth, err := s.router.WETH9(callOpts)
+	if err != nil {
+		return err
+	}
+
+	return s.client.ExpectAddress("smart router WETH9()", weth, s.client.Config.WrappedNative)
+}
+
+// GetQuote quotes req split across the chain's pools, the response lists the legs of the split.
+func (s *Smart) GetQuote(ctx context.Context, req *quoteswap.GetQuoteRequest) (resp *quoteswap.GetQuoteResponse, err error) {
+	if err := s.Verify(ctx); err != nil {
+		return nil, err
+	}
+
+	amountIn, err := pancakeswap.AmountIn(req)
+	if err != nil {
+		return nil, err
+	}
+	if amountIn.Sign() == 0 {
+		return nil, errors.New("failed to get quote: amount in is zero")
+	}
+
+	logrus.Infof("Request parameters: TokenIn: %s, TokenOut: %s, Amount: %s, Slippage: %d, Chain: %s", req.TokenIn, req.TokenOut, amountIn, req.SlippageBps, req.Chain)
+
+	legs, err := s.split(ctx, req, amountIn)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &quoteswap.GetQuoteResponse{
+		InputToken:  req.TokenIn,
+		InAmount:    amountIn.String(),
+		OutputToken: req.TokenOut,
+		SlippageBps: int32(req.SlippageBps),
+		Dex:         req.Dex,
+		Chain:       s.client.Chain,
+	}
+
+	amountOut := new(big.Int)
+	for _, leg := range legs {
+		amountOut.Add(amountOut, leg.amountOut)
+		resp.Splits = append(resp.Splits, &quoteswap.SplitLeg{
+			Pool:      &quoteswap.Pool{Dex: leg.pool.Dex, Address: leg.pool.Address.Hex(), Fee: leg.pool.Fee},
+			InAmount:  leg.amountIn.String(),
+			OutAmount: leg.amountOut.String(),
+		})
+	}
+	resp.OutAmount = amountOut.String()
+
+	return resp, nil
+}
+
+// ExecuteSwap swaps every leg of a split quote in one multicall, so either all legs fill or none
+// does. As on every dex, out amounts are minimum outputs: each leg's is its out_amount, slippage_bps
+// is not applied again.
+func (s *Smart) ExecuteSwap(ctx context.Context, req *quoteswap.ExecuteTxRequest) (resp *quoteswap.ExecuteTxResponse, err error) {
+	if err := s.Verify(ctx); err != nil {
+		return nil, err
+	}
+
+	quote := req.QuotingResponse
+	if len(quote.GetSplits()) == 0 {
+		return nil, errors.New("the quote has no split legs")
+	}
+
+	tokenIn := common.HexToAddress(quote.InputToken)
+	tokenOut := common.HexToAddress(quote.OutputToken)
+
+	recipient := common.HexToAddress(os.Getenv("RECIPIENT_ADDR"))
+
+	amountIn := new(big.Int)
+	data := make([][]byte, 0, len(quote.GetSplits()))
+	for _, split := range quote.GetSplits() {
+		callData, legIn, err := s.legCall(split, tokenIn, tokenOut, recipient)
+		if err != nil {
+			return nil, err
+		}
+		amountIn.Add(amountIn, legIn)
+		data = append(data, callData)
+	}
+
+	err = s.approveToken(ctx, tokenIn, recipient, s.routerAddress, amountIn)
+	if err != nil {
+		resp = &quoteswap.ExecuteTxResponse{
+			Status: quoteswap.TransactionStatus_FAILED,
+			Error: &quoteswap.Error{
+				Code:    5,
+				Message: fmt.Sprintf("approval failed: %s", err.Error()),
+			},
+		}
+
+		return resp, err
+	}
+
+	deadline := big.NewInt(time.Now().Add(10 * time.Minute).Unix())
+
+	logrus.Infof("Preparing split swap with parameters:\n TokenIn: %s;\n TokenOut: %s;\n AmountIn: %s;\n Legs: %d;\n Recipient: %s;\n Deadline: %s;\n",
+		tokenIn.Hex(), tokenOut.Hex(), amountIn.String(), len(data), recipient.Hex(), deadline.String())
+
+	opts, err := s.opts(ctx)
+	if err != nil {
+		resp = &quoteswap.ExecuteTxResponse{
+			Status: quoteswap.TransactionStatus_FAILED,
+			Error: &quoteswap.Error{
+				Code:    5,
+				Message: fmt.Sprintf("getting opts failed: %s", err.Error()),
+			},
+		}
+
+		return resp, err
+	}
+
+	tx, err := s.router.Multicall(opts, deadline, data)
+	if err != nil {
+		return &quoteswap.ExecuteTxResponse{
+			Status: quoteswap.TransactionStatus_FAILED,
+			Error:  &quoteswap.Error{Code: 5, Message: err.Error()},
+		}, nil
+	}
+
+	resp = &quoteswap.ExecuteTxResponse{
+		TransactionHash: tx.Hash().Hex(),
+		Status:          quoteswap.TransactionStatus_PENDING,
+		SellTokenQty:    pancakeswap.Float64(amountIn),
+		SellTokenAmount: amountIn.String(),
+	}
+
+	return resp, nil
+}
+
+// legCall encodes the router call swapping one leg: swapExactTokensForTokens through a V2 pair or
+// exactInputSingle through a V3 pool, with the leg's out amount as its minimum output. It returns
+// the call data and the leg's input amount.
+func (s *Smart) legCall(split *quoteswap.SplitLeg, tokenIn, tokenOut, recipient common.Address) ([]byte, *big.Int, error) {
+	amountIn, err := pancakeswap.ParseAmount(split.GetInAmount())
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid leg amount in value: %w", err)
+	}
+
+	amountOutMin, err := pancakeswap.ParseAmount(split.GetOutAmount())
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid leg amount out value: %w", err)
+	}
+
+	var callData []byte
+	switch split.GetPool().GetDex() {
+	case registry.DexV2:
+		callData, err = s.routerABI.Pack("swapExactTokensForTokens", amountIn, amountOutMin, []common.Address{tokenIn, tokenOut}, recipient)
+	case registry.DexV3:
+		callData, err = s.routerABI.Pack("exactInputSingle", smartRouter.IV3SwapRouterExactInputSingleParams{
+			TokenIn:           tokenIn,
+			TokenOut:          tokenOut,
+			Fee:               new(big.Int).SetUint64(uint64(split.GetPool().GetFee())),
+			Recipient:         recipient,
+			AmountIn:          amountIn,
+			AmountOutMinimum:  amountOutMin,
+			SqrtPriceLimitX96: new(big.Int),
+		})
+	default:
+		return nil, nil, fmt.Errorf("unsupported leg dex: %q", split.GetPool().GetDex())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return callData, amountIn, nil
+}
+
+func (s *Smart) approveToken(ctx context.Context, tokenAddress, ownerAddress, spenderAddress common.Address, amount *big.Int) error {
+	token, err := erc20.NewBlockchain(tokenAddress, s.client.Eth())
+	if err != nil {
+		return err
+	}
+
+	allowance, err := token.Allowance(&bind.CallOpts{Context: ctx}, ownerAddress, spenderAddress)
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("Allowance for %s to spend: %s", s.routerAddress.Hex(), allowance.String())
+
+	if allowance.Cmp(amount) >= 0 {
+		return nil
+	}
+
+	opts, err := s.opts(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := token.Approve(opts, spenderAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := bind.WaitMined(ctx, s.client.Eth(), tx.Hash())
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("approve tx %s failed", tx.Hash().Hex())
+	}
+	logrus.Info("approve tx was successful")
+
+	return nil
+}
+
+// opts returns the transact options of a transaction. The gas limit is left to estimation, a
+// multicall's grows with the number of legs.
+func (s *Smart) opts(ctx context.Context) (*bind.TransactOpts, error) {
+	// Refuse to sign for a node that serves another chain than the one configured.
+	if err := s.client.VerifyChainID(ctx); err != nil {
+		return nil, err
+	}
+	chainID := new(big.Int).SetUint64(s.client.Config.ChainID)
+
+	privateKeyECDSA, err := crypto.HexToECDSA(strings.TrimPrefix(os.Getenv("PRIVATE_KEY"), "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	auth := bind.NewKeyedTransactor(privateKeyECDSA, chainID)
+
+	nonce, err := s.client.Eth().PendingNonceAt(ctx, auth.From)
+	if err != nil {
+		return nil, err
+	}
+	auth.Nonce = new(big.Int).SetUint64(nonce)
+
+	auth.GasTipCap, err = s.client.Eth().SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := s.client.Eth().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	auth.GasFeeCap = new(big.Int).Add(header.BaseFee, auth.GasTipCap)
+
+	auth.Value = big.NewInt(0)
+	auth.Context = ctx
+
+	return auth, nil
+}
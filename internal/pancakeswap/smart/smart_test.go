@@ -0,0 +1,68 @@
+package smart
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain/abi/gen/smartRouter"
+	"grpc_cake/internal/registry"
+)
+
+func TestLegCall(t *testing.T) {
+	routerABI, err := smartRouter.BlockchainMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Smart{routerABI: routerABI}
+	tokenIn := common.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+	tokenOut := common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+	recipient := common.HexToAddress("0x1")
+
+	tests := []struct {
+		name   string
+		leg    *quoteswap.SplitLeg
+		method string
+	}{
+		{"v2 leg", &quoteswap.SplitLeg{Pool: &quoteswap.Pool{Dex: registry.DexV2, Fee: 2500}, InAmount: "300", OutAmount: "9950"}, "swapExactTokensForTokens"},
+		{"v3 leg", &quoteswap.SplitLeg{Pool: &quoteswap.Pool{Dex: registry.DexV3, Fee: 500}, InAmount: "700", OutAmount: "19900"}, "exactInputSingle"},
+	}
+
+	for _, tt := range tests {
+		data, amountIn, err := s.legCall(tt.leg, tokenIn, tokenOut, recipient)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if amountIn.String() != tt.leg.GetInAmount() {
+			t.Errorf("%s: amount in %s, want %s", tt.name, amountIn, tt.leg.GetInAmount())
+		}
+
+		method, err := routerABI.MethodById(data[:4])
+		if err != nil || method.Name != tt.method {
+			t.Fatalf("%s: got method %v, want %s", tt.name, method, tt.method)
+		}
+		args, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		// The leg's out amount is the minimum output as is, slippage_bps is not applied again.
+		var minOut *big.Int
+		switch tt.method {
+		case "swapExactTokensForTokens":
+			minOut = args[1].(*big.Int)
+		default:
+			params := abi.ConvertType(args[0], new(smartRouter.IV3SwapRouterExactInputSingleParams)).(*smartRouter.IV3SwapRouterExactInputSingleParams)
+			minOut = params.AmountOutMinimum
+		}
+		if minOut.String() != tt.leg.GetOutAmount() {
+			t.Errorf("%s: minimum output %s, want %s", tt.name, minOut, tt.leg.GetOutAmount())
+		}
+	}
+
+	if _, _, err := s.legCall(&quoteswap.SplitLeg{Pool: &quoteswap.Pool{Dex: registry.DexSmart}, InAmount: "1", OutAmount: "1"}, tokenIn, tokenOut, recipient); err == nil {
+		t.Error("leg of an unsupported dex: expected an error")
+	}
+}
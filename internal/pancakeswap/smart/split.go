@@ -0,0 +1,105 @@
+package smart
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// splitParts is the number of equal parts an order is divided into before they are given to pools.
+const splitParts = 20
+
+// leg is the share of an order swapped through one pool.
+type leg struct {
+	pool      pancakeswap.Pool
+	amountIn  *big.Int
+	amountOut *big.Int
+}
+
+// split divides amountIn across the V2 and V3 pools of req's pair so the total output is maximal.
+// Every pool is quoted at each multiple of amountIn/splitParts, and the parts are handed out one by
+// one to the pool whose output grows the most by it. A pool's output is concave in its input, so
+// this greedy allocation is optimal for the parts. The gas of an additional leg is not weighed.
+func (s *Smart) split(ctx context.Context, req *quoteswap.GetQuoteRequest, amountIn *big.Int) ([]leg, error) {
+	amounts := make([]*big.Int, splitParts)
+	for k := range amounts {
+		amounts[k] = new(big.Int).Mul(amountIn, big.NewInt(int64(k+1)))
+		amounts[k].Div(amounts[k], big.NewInt(splitParts))
+	}
+
+	var curves []pancakeswap.Curve
+	for _, quoter := range s.quoters {
+		quoted, err := quoter.QuoteCurves(ctx, req, amounts)
+		if err != nil {
+			logrus.Warnf("failed to quote %s/%s pools on %s for a split: %v", req.GetTokenIn(), req.GetTokenOut(), s.client.Chain, err)
+			continue
+		}
+		curves = append(curves, quoted...)
+	}
+
+	parts := allocate(curves)
+
+	var (
+		legs      []leg
+		allocated = new(big.Int)
+	)
+	for i, n := range parts {
+		if n == 0 || amounts[n-1].Sign() == 0 {
+			continue
+		}
+		legs = append(legs, leg{pool: curves[i].Pool, amountIn: amounts[n-1], amountOut: curves[i].AmountsOut[n-1]})
+		allocated.Add(allocated, amounts[n-1])
+	}
+	if len(legs) == 0 {
+		return nil, errors.New("failed to get quote: no pool can swap the amount")
+	}
+
+	sort.Slice(legs, func(i, j int) bool {
+		return legs[i].amountIn.Cmp(legs[j].amountIn) > 0
+	})
+
+	// Rounding leaves a few base units unallocated. They go to the largest leg, whose quoted output
+	// then slightly understates what it returns.
+	legs[0].amountIn = new(big.Int).Add(legs[0].amountIn, allocated.Sub(amountIn, allocated))
+
+	return legs, nil
+}
+
+// allocate hands the splitParts parts of an order out one at a time to the curve gaining the most
+// output from it, and returns how many parts each curve got. Every part must be placed, otherwise
+// no curve gets any.
+func allocate(curves []pancakeswap.Curve) []int {
+	parts := make([]int, len(curves))
+
+	for range splitParts {
+		var (
+			best     = -1
+			bestGain *big.Int
+		)
+		for i, curve := range curves {
+			next := curve.AmountsOut[parts[i]]
+			if next == nil {
+				continue
+			}
+
+			gain := new(big.Int).Set(next)
+			if parts[i] > 0 {
+				gain.Sub(gain, curve.AmountsOut[parts[i]-1])
+			}
+			if best < 0 || gain.Cmp(bestGain) > 0 {
+				best, bestGain = i, gain
+			}
+		}
+		if best < 0 {
+			return make([]int, len(curves))
+		}
+		parts[best]++
+	}
+
+	return parts
+}
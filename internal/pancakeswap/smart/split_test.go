@@ -0,0 +1,61 @@
+package smart
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"grpc_cake/internal/pancakeswap"
+)
+
+// curve returns a curve whose output grows by gain(k) with the k-th part, nil from the part on
+// where gain returns a negative value.
+func curve(gain func(k int64) int64) pancakeswap.Curve {
+	c := pancakeswap.Curve{AmountsOut: make([]*big.Int, splitParts)}
+	out, failed := int64(0), false
+	for k := range int64(splitParts) {
+		g := gain(k)
+		if failed = failed || g < 0; failed {
+			continue
+		}
+		out += g
+		c.AmountsOut[k] = big.NewInt(out)
+	}
+
+	return c
+}
+
+func TestAllocate(t *testing.T) {
+	deep := curve(func(k int64) int64 { return max(1, 100-10*k) })
+	shallow := curve(func(k int64) int64 { return 55 - k })
+	linear := curve(func(int64) int64 { return 30 })
+	capped := curve(func(k int64) int64 {
+		if k >= 5 {
+			return -1
+		}
+		return 1000
+	})
+	failed := curve(func(int64) int64 { return -1 })
+
+	tests := []struct {
+		name   string
+		curves []pancakeswap.Curve
+		want   []int
+	}{
+		{"single pool", []pancakeswap.Curve{shallow}, []int{20}},
+		{"parts go to the largest gain", []pancakeswap.Curve{deep, shallow}, []int{6, 14}},
+		{"order of the pools does not matter", []pancakeswap.Curve{shallow, deep}, []int{14, 6}},
+		{"pool never gaining the most", []pancakeswap.Curve{deep, shallow, linear}, []int{6, 14, 0}},
+		{"equal gains go to the first pool", []pancakeswap.Curve{linear, linear}, []int{20, 0}},
+		{"pool that cannot swap more", []pancakeswap.Curve{capped, shallow}, []int{5, 15}},
+		{"pool that cannot swap at all", []pancakeswap.Curve{failed, shallow}, []int{0, 20}},
+		{"parts left unplaced", []pancakeswap.Curve{capped, failed}, []int{0, 0}},
+		{"no pools", nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		if got := allocate(tt.curves); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -2,11 +2,55 @@ package pancakeswap
 
 import (
 	"context"
+	"sync"
 
 	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
 )
 
 type Swapper interface {
 	GetQuote(ctx context.Context, req *quoteswap.GetQuoteRequest) (resp *quoteswap.GetQuoteResponse, err error)
 	ExecuteSwap(ctx context.Context, req *quoteswap.ExecuteTxRequest) (resp *quoteswap.ExecuteTxResponse, err error)
 }
+
+// BatchQuoter is implemented by swappers that can express a quote as plain contract calls,
+// so quotes for many requests on one chain can be aggregated into a single Multicall3 call.
+type BatchQuoter interface {
+	QuoteCalls(req *quoteswap.GetQuoteRequest) (*QuoteCalls, error)
+}
+
+// QuoteCalls holds the calls needed to quote one request and the function turning
+// their results, in the same order, into a quote response.
+type QuoteCalls struct {
+	Calls  []blockchain.Call
+	Decode func(results []blockchain.CallResult) (*quoteswap.GetQuoteResponse, error)
+}
+
+// Verifier is implemented by swappers that can check their contracts against the registry.
+// Verify fails with an error wrapping blockchain.ErrMisconfigured when the wiring is wrong.
+type Verifier interface {
+	Verify(ctx context.Context) error
+}
+
+// Verification runs a swapper's wiring check until it first succeeds, so a chain that could not
+// be verified at startup is verified before its first use instead.
+type Verification struct {
+	mu   sync.Mutex
+	done bool
+}
+
+func (v *Verification) Ensure(ctx context.Context, verify func(ctx context.Context) error) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.done {
+		return nil
+	}
+
+	if err := verify(ctx); err != nil {
+		return err
+	}
+	v.done = true
+
+	return nil
+}
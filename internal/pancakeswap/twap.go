@@ -0,0 +1,32 @@
+package pancakeswap
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/internal/blockchain"
+)
+
+// Twap is the time-weighted average price of a pool over a window ending at block To.
+type Twap struct {
+	// Pool identifies the pool, its state is not set.
+	Pool Pool
+	To   blockchain.Block
+	// Window is the number of seconds averaged over.
+	Window uint64
+
+	// Amount0 of token0 was worth Amount1 of token1 on average.
+	Amount0 *big.Int
+	Amount1 *big.Int
+
+	// Tick and HarmonicMeanLiquidity are the arithmetic mean tick and harmonic mean liquidity of a V3 pool.
+	Tick                  int32
+	HarmonicMeanLiquidity *big.Int
+}
+
+// TwapReader is implemented by swappers that can read average prices from their pools' oracles.
+type TwapReader interface {
+	// Twap averages the price of the pool of tokenA and tokenB with fee over the last window seconds.
+	Twap(ctx context.Context, tokenA, tokenB common.Address, fee uint32, window uint64) (*Twap, error)
+}
@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// QuoteCurves quotes amountsIn through the pair of req's tokens from its reserves at req's block,
+// the same constant product getAmountsOut computes.
+func (v *V2) QuoteCurves(ctx context.Context, req *quoteswap.GetQuoteRequest, amountsIn []*big.Int) ([]pancakeswap.Curve, error) {
+	if err := v.Verify(ctx); err != nil {
+		return nil, err
+	}
+
+	pool, err := v.QuotePool(ctx, req, 0)
+	if err != nil || pool == nil {
+		return nil, err
+	}
+
+	reserveIn, reserveOut := pool.Reserve0, pool.Reserve1
+	if common.HexToAddress(req.TokenIn) != pool.Token0 {
+		reserveIn, reserveOut = reserveOut, reserveIn
+	}
+	if reserveIn.Sign() == 0 || reserveOut.Sign() == 0 {
+		return nil, nil
+	}
+
+	curve := pancakeswap.Curve{Pool: *pool, AmountsOut: make([]*big.Int, len(amountsIn))}
+	for i, amountIn := range amountsIn {
+		curve.AmountsOut[i] = GetAmountOut(amountIn, reserveIn, reserveOut, v.dex.FeeBps)
+	}
+
+	return []pancakeswap.Curve{curve}, nil
+}
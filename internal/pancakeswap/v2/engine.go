@@ -0,0 +1,340 @@
+package v2
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/blockchain/abi/gen/factoryV2"
+	"grpc_cake/internal/blockchain/abi/gen/pairV2"
+)
+
+const (
+	// maxSyncRange is how many blocks of Sync logs are fetched at once. Pairs lagging further
+	// behind the head are dropped and reloaded on their next quote.
+	maxSyncRange = 1000
+	// checkEvery is how often a local quote is checked against the router, besides the first
+	// quote of every pair.
+	checkEvery = 100
+	// syncTimeout bounds fetching the Sync logs of a new head.
+	syncTimeout = 10 * time.Second
+)
+
+var pairABI, _ = pairV2.BlockchainMetaData.GetAbi()
+
+// engine quotes V2 swaps locally from cached pair reserves. Reserves are loaded with getReserves
+// on a pair's first quote and kept current from the pairs' Sync events on every new head.
+type engine struct {
+	client   *blockchain.Client
+	factory  common.Address
+	feeBps   uint32
+	pairs    *pairV2.BlockchainFilterer
+	resolver func(ctx context.Context) (common.Address, error)
+
+	mu     sync.Mutex
+	state  map[[2]common.Address]*pair
+	quotes uint64
+	// syncing is set while Sync logs are fetched, pending is the latest head seen meanwhile.
+	syncing bool
+	pending *blockchain.Block
+}
+
+// pair is the cached state of a V2 pair at block at.
+type pair struct {
+	address  common.Address
+	reserve0 *big.Int
+	reserve1 *big.Int
+	at       uint64
+	// checked is set once a local quote of the pair matched the router.
+	checked bool
+}
+
+// newEngine creates an engine for the pairs of the factory, a zero factory is resolved on first use.
+func newEngine(client *blockchain.Client, factory common.Address, feeBps uint32, resolver func(ctx context.Context) (common.Address, error)) *engine {
+	// The filterer is only used to parse logs, which does not depend on the contract address.
+	pairs, _ := pairV2.NewBlockchainFilterer(common.Address{}, client.Eth())
+
+	e := &engine{
+		client:   client,
+		factory:  factory,
+		feeBps:   feeBps,
+		pairs:    pairs,
+		resolver: resolver,
+		state:    make(map[[2]common.Address]*pair),
+	}
+	client.OnNewHead(e.onHead)
+
+	return e
+}
+
+// GetAmountOut is the PancakeSwap V2 library's getAmountOut for a fee of feeBps basis points.
+func GetAmountOut(amountIn, reserveIn, reserveOut *big.Int, feeBps uint32) *big.Int {
+	amountInWithFee := new(big.Int).Mul(amountIn, big.NewInt(int64(10000-feeBps)))
+	numerator := new(big.Int).Mul(amountInWithFee, reserveOut)
+	denominator := new(big.Int).Mul(reserveIn, big.NewInt(10000))
+	denominator.Add(denominator, amountInWithFee)
+
+	return numerator.Div(numerator, denominator)
+}
+
+// quote returns the local quote of amountIn at block, loading the pair when it is not cached yet
+// and block is the head. check reports whether the quote should be compared with the router.
+// ok is false when the pair cannot be quoted locally at block, the caller then falls back to the router.
+func (e *engine) quote(ctx context.Context, tokenIn, tokenOut common.Address, amountIn *big.Int, block uint64) (amountOut *big.Int, check, ok bool) {
+	if amountIn.Sign() <= 0 || block == 0 {
+		return nil, false, false
+	}
+
+	key := pairKey(tokenIn, tokenOut)
+
+	e.mu.Lock()
+	p := e.state[key]
+	e.mu.Unlock()
+
+	// Past blocks are quoted by the router, only pairs at the head are worth caching.
+	if p == nil && block == e.client.Head().Number {
+		var err error
+		if p, err = e.load(ctx, key, block); err != nil {
+			logrus.Debugf("failed to load V2 pair %s/%s on %s: %v", key[0].Hex(), key[1].Hex(), e.client.Chain, err)
+			return nil, false, false
+		}
+	}
+
+	return e.cached(tokenIn, tokenOut, amountIn, block)
+}
+
+// cached is quote without loading missing pairs, it never calls the chain.
+func (e *engine) cached(tokenIn, tokenOut common.Address, amountIn *big.Int, block uint64) (amountOut *big.Int, check, ok bool) {
+	if amountIn.Sign() <= 0 || block == 0 {
+		return nil, false, false
+	}
+
+	key := pairKey(tokenIn, tokenOut)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p := e.state[key]
+	if p == nil || p.at != block || p.reserve0.Sign() == 0 || p.reserve1.Sign() == 0 {
+		return nil, false, false
+	}
+
+	reserveIn, reserveOut := p.reserve0, p.reserve1
+	if tokenIn != key[0] {
+		reserveIn, reserveOut = reserveOut, reserveIn
+	}
+
+	e.quotes++
+	return GetAmountOut(amountIn, reserveIn, reserveOut, e.feeBps), !p.checked || e.quotes%checkEvery == 0, true
+}
+
+// snapshot returns a copy of the pair of the tokens when it is cached at block.
+func (e *engine) snapshot(tokenA, tokenB common.Address, block uint64) *pair {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p := e.state[pairKey(tokenA, tokenB)]
+	if p == nil || p.at != block || block == 0 {
+		return nil
+	}
+	snapshot := *p
+
+	return &snapshot
+}
+
+// checked records the outcome of comparing a local quote of the pair with the router.
+// A mismatch drops the pair, so it is quoted by the router until reloaded.
+func (e *engine) checked(tokenIn, tokenOut common.Address, local, router *big.Int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	key := pairKey(tokenIn, tokenOut)
+	p := e.state[key]
+	if p == nil {
+		return
+	}
+
+	if local.Cmp(router) != 0 {
+		logrus.Errorf("local V2 quote of %s on %s is %s, router quotes %s, dropping pair", p.address.Hex(), e.client.Chain, local, router)
+		delete(e.state, key)
+		return
+	}
+	p.checked = true
+}
+
+// load reads the pair of key and its reserves at block.
+func (e *engine) load(ctx context.Context, key [2]common.Address, block uint64) (*pair, error) {
+	callOpts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}
+
+	e.mu.Lock()
+	factoryAddress := e.factory
+	e.mu.Unlock()
+
+	if factoryAddress == (common.Address{}) {
+		var err error
+		if factoryAddress, err = e.resolver(ctx); err != nil {
+			return nil, err
+		}
+
+		e.mu.Lock()
+		e.factory = factoryAddress
+		e.mu.Unlock()
+	}
+
+	factory, err := factoryV2.NewBlockchainCaller(factoryAddress, e.client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	pairAddress, err := factory.GetPair(callOpts, key[0], key[1])
+	if err != nil {
+		return nil, err
+	}
+	if pairAddress == (common.Address{}) {
+		return nil, ethereum.NotFound
+	}
+
+	caller, err := pairV2.NewBlockchainCaller(pairAddress, e.client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	reserves, err := caller.GetReserves(callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pair{address: pairAddress, reserve0: reserves.Reserve0, reserve1: reserves.Reserve1, at: block}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// A concurrent load or a newer head may have won the race, keep the most recent state.
+	if existing := e.state[key]; existing != nil && existing.at >= block {
+		return existing, nil
+	}
+	e.state[key] = p
+
+	return p, nil
+}
+
+// onHead syncs the cached pairs to head on its own goroutine, so a slow node does not hold up
+// the client's other head listeners. Heads arriving during a sync are coalesced into the latest.
+func (e *engine) onHead(head blockchain.Block) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.syncing {
+		e.pending = &head
+		return
+	}
+	e.syncing = true
+
+	go e.syncLoop(head)
+}
+
+// syncLoop syncs to head, then to the latest head that arrived meanwhile until there is none.
+func (e *engine) syncLoop(head blockchain.Block) {
+	for {
+		e.sync(head)
+
+		e.mu.Lock()
+		if e.pending == nil {
+			e.syncing = false
+			e.mu.Unlock()
+			return
+		}
+		head, e.pending = *e.pending, nil
+		e.mu.Unlock()
+	}
+}
+
+// sync applies the Sync events of every cached pair up to head.
+func (e *engine) sync(head blockchain.Block) {
+	e.mu.Lock()
+	var (
+		from      = head.Number
+		addresses []common.Address
+	)
+	for key, p := range e.state {
+		if p.at >= head.Number {
+			continue
+		}
+		if head.Number-p.at > maxSyncRange {
+			delete(e.state, key)
+			continue
+		}
+		from = min(from, p.at+1)
+		addresses = append(addresses, p.address)
+	}
+	e.mu.Unlock()
+
+	if len(addresses) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+	defer cancel()
+
+	logs, err := e.client.Eth().FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(head.Number),
+		Addresses: addresses,
+		Topics:    [][]common.Hash{{pairABI.Events["Sync"].ID}},
+	})
+	if err != nil {
+		// The pairs stay at their block and are quoted by the router until the next head catches up.
+		logrus.Warnf("failed to sync V2 reserves on %s: %v", e.client.Chain, err)
+		return
+	}
+
+	e.apply(logs, addresses, head.Number)
+}
+
+// apply sets the reserves of the cached pairs from their Sync logs, in block order, and moves the
+// pairs of addresses, whose logs were fetched up to head, to head. Logs at or before a pair's
+// block and removed logs are ignored.
+func (e *engine) apply(logs []types.Log, addresses []common.Address, head uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	byAddress := make(map[common.Address]*pair, len(e.state))
+	for _, p := range e.state {
+		byAddress[p.address] = p
+	}
+
+	for _, log := range logs {
+		p := byAddress[log.Address]
+		if p == nil || log.BlockNumber <= p.at || log.Removed {
+			continue
+		}
+
+		event, err := e.pairs.ParseSync(log)
+		if err != nil {
+			continue
+		}
+		p.reserve0, p.reserve1 = event.Reserve0, event.Reserve1
+	}
+
+	for _, address := range addresses {
+		if p := byAddress[address]; p != nil && p.at < head {
+			p.at = head
+		}
+	}
+}
+
+// pairKey returns the tokens of a pair sorted like the pair's token0 and token1.
+func pairKey(tokenA, tokenB common.Address) [2]common.Address {
+	if tokenB.Cmp(tokenA) < 0 {
+		return [2]common.Address{tokenB, tokenA}
+	}
+
+	return [2]common.Address{tokenA, tokenB}
+}
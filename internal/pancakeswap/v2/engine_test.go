@@ -0,0 +1,139 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/blockchain/abi/gen/pairV2"
+)
+
+var (
+	tokenA = common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+	tokenB = common.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+)
+
+func exp18(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+// Expected outputs follow PancakeLibrary.getAmountOut, amountIn * (10000 - fee) * reserveOut over
+// reserveIn * 10000 + amountIn * (10000 - fee), in integer arithmetic.
+func TestGetAmountOut(t *testing.T) {
+	tests := []struct {
+		name                            string
+		amountIn, reserveIn, reserveOut *big.Int
+		feeBps                          uint32
+		want                            string
+	}{
+		{"PancakeSwap fee", exp18(1), exp18(100), exp18(30000), 25, "296294462734226094705"},
+		{"Uniswap fee", exp18(1), exp18(100), exp18(30000), 30, "296147410319118389655"},
+		{"other direction", big.NewInt(5e17), exp18(30000), exp18(100), 25, "1662472361396991"},
+		{"rounded down", big.NewInt(1e6), big.NewInt(123456789), big.NewInt(987654321987), 25, "7916040452"},
+		{"dust", big.NewInt(1), big.NewInt(1000), big.NewInt(1000), 25, "0"},
+		{"drained reserves", exp18(1), big.NewInt(1), big.NewInt(1), 25, "0"},
+	}
+
+	for _, tt := range tests {
+		if got := GetAmountOut(tt.amountIn, tt.reserveIn, tt.reserveOut, tt.feeBps); got.String() != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func newTestEngine(t *testing.T) *engine {
+	t.Helper()
+
+	pairs, err := pairV2.NewBlockchainFilterer(common.Address{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &engine{client: &blockchain.Client{Chain: "bsc"}, feeBps: 25, pairs: pairs, state: make(map[[2]common.Address]*pair)}
+}
+
+func TestEngineCached(t *testing.T) {
+	e := newTestEngine(t)
+	key := pairKey(tokenA, tokenB)
+	e.state[key] = &pair{address: common.HexToAddress("0x1"), reserve0: exp18(100), reserve1: exp18(30000), at: 100}
+
+	tests := []struct {
+		name              string
+		tokenIn, tokenOut common.Address
+		block             uint64
+		want              *big.Int
+	}{
+		{"token0 in", key[0], key[1], 100, GetAmountOut(exp18(1), exp18(100), exp18(30000), 25)},
+		{"token1 in", key[1], key[0], 100, GetAmountOut(exp18(1), exp18(30000), exp18(100), 25)},
+		{"another block", key[0], key[1], 101, nil},
+		{"no block", key[0], key[1], 0, nil},
+		{"unknown pair", key[0], common.HexToAddress("0x2"), 100, nil},
+	}
+
+	for _, tt := range tests {
+		got, _, ok := e.cached(tt.tokenIn, tt.tokenOut, exp18(1), tt.block)
+		if ok != (tt.want != nil) || (ok && got.Cmp(tt.want) != 0) {
+			t.Errorf("%s: got %s ok %t, want %v", tt.name, got, ok, tt.want)
+		}
+	}
+
+	if _, check, _ := e.cached(key[0], key[1], exp18(1), 100); !check {
+		t.Error("an unchecked pair is not checked against the router")
+	}
+	local, _, _ := e.cached(key[0], key[1], exp18(1), 100)
+	e.checked(key[0], key[1], local, new(big.Int).Set(local))
+	if _, check, _ := e.cached(key[0], key[1], exp18(1), 100); check && e.quotes%checkEvery != 0 {
+		t.Error("a checked pair is checked again")
+	}
+	e.checked(key[0], key[1], local, new(big.Int).Add(local, big.NewInt(1)))
+	if _, _, ok := e.cached(key[0], key[1], exp18(1), 100); ok {
+		t.Error("a pair quoting unlike the router is still quoted locally")
+	}
+
+	e.state[key] = &pair{address: common.HexToAddress("0x1"), reserve0: new(big.Int), reserve1: exp18(1), at: 100}
+	if _, _, ok := e.cached(key[0], key[1], exp18(1), 100); ok {
+		t.Error("a pair without reserves is quoted locally")
+	}
+}
+
+func TestEngineApply(t *testing.T) {
+	e := newTestEngine(t)
+	synced, idle := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	e.state[pairKey(tokenA, tokenB)] = &pair{address: synced, reserve0: big.NewInt(1000), reserve1: big.NewInt(2000), at: 100}
+	e.state[pairKey(tokenA, common.HexToAddress("0x3"))] = &pair{address: idle, reserve0: big.NewInt(10), reserve1: big.NewInt(20), at: 104}
+
+	syncLog := func(address common.Address, block uint64, reserve0, reserve1 int64, removed bool) types.Log {
+		data, err := pairABI.Events["Sync"].Inputs.NonIndexed().Pack(big.NewInt(reserve0), big.NewInt(reserve1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return types.Log{Address: address, Topics: []common.Hash{pairABI.Events["Sync"].ID}, Data: data, BlockNumber: block, Removed: removed}
+	}
+
+	e.apply([]types.Log{
+		syncLog(synced, 100, 1, 1, false),
+		syncLog(synced, 101, 1100, 1900, false),
+		syncLog(common.HexToAddress("0x4"), 102, 1, 1, false),
+		syncLog(synced, 103, 1200, 1800, false),
+		syncLog(synced, 104, 1, 1, true),
+		syncLog(idle, 104, 1, 1, false),
+	}, []common.Address{synced}, 105)
+
+	tests := []struct {
+		name               string
+		pair               *pair
+		reserve0, reserve1 int64
+		at                 uint64
+	}{
+		{"synced pair", e.state[pairKey(tokenA, tokenB)], 1200, 1800, 105},
+		{"pair at the log's block", e.state[pairKey(tokenA, common.HexToAddress("0x3"))], 10, 20, 104},
+	}
+
+	for _, tt := range tests {
+		if tt.pair.reserve0.Int64() != tt.reserve0 || tt.pair.reserve1.Int64() != tt.reserve1 || tt.pair.at != tt.at {
+			t.Errorf("%s: got reserves %s/%s at %d, want %d/%d at %d", tt.name, tt.pair.reserve0, tt.pair.reserve1, tt.pair.at, tt.reserve0, tt.reserve1, tt.at)
+		}
+	}
+}
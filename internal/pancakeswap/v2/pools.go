@@ -0,0 +1,88 @@
+package v2
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain/abi/gen/factoryV2"
+	"grpc_cake/internal/blockchain/abi/gen/pairV2"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// Pools returns the pair of tokenA and tokenB with its reserves, if the factory has created it.
+func (v *V2) Pools(ctx context.Context, tokenA, tokenB common.Address) ([]pancakeswap.Pool, error) {
+	pool, err := v.pair(&bind.CallOpts{Context: ctx}, tokenA, tokenB)
+	if err != nil || pool == nil {
+		return nil, err
+	}
+
+	return []pancakeswap.Pool{*pool}, nil
+}
+
+// QuotePool returns the pair a quote of req went through, from the engine's reserves when they
+// are cached at req's block. V2 has a single pair per token pair, so fee is ignored.
+func (v *V2) QuotePool(ctx context.Context, req *quoteswap.GetQuoteRequest, fee uint32) (*pancakeswap.Pool, error) {
+	tokenIn, tokenOut := common.HexToAddress(req.TokenIn), common.HexToAddress(req.TokenOut)
+
+	if v.engine != nil && req.GetBlockHash() == "" {
+		if p := v.engine.snapshot(tokenIn, tokenOut, req.GetBlockNumber()); p != nil {
+			key := pairKey(tokenIn, tokenOut)
+			return v.poolOf(p.address, key[0], key[1], p.reserve0, p.reserve1), nil
+		}
+	}
+
+	return v.pair(pancakeswap.CallOpts(ctx, req), tokenIn, tokenOut)
+}
+
+// pair reads the pair of tokenA and tokenB and its reserves, nil when the factory has not created it.
+func (v *V2) pair(callOpts *bind.CallOpts, tokenA, tokenB common.Address) (*pancakeswap.Pool, error) {
+	factoryAddress := v.dex.Factory
+	if factoryAddress == (common.Address{}) {
+		address, err := v.router.Factory(callOpts)
+		if err != nil {
+			return nil, err
+		}
+		factoryAddress = address
+	}
+
+	factory, err := factoryV2.NewBlockchainCaller(factoryAddress, v.client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	pairAddress, err := factory.GetPair(callOpts, tokenA, tokenB)
+	if err != nil {
+		return nil, err
+	}
+	if pairAddress == (common.Address{}) {
+		return nil, nil
+	}
+
+	pair, err := pairV2.NewBlockchainCaller(pairAddress, v.client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	reserves, err := pair.GetReserves(callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	key := pairKey(tokenA, tokenB)
+	return v.poolOf(pairAddress, key[0], key[1], reserves.Reserve0, reserves.Reserve1), nil
+}
+
+func (v *V2) poolOf(address, token0, token1 common.Address, reserve0, reserve1 *big.Int) *pancakeswap.Pool {
+	return &pancakeswap.Pool{
+		Dex:      v.dex.Name,
+		Address:  address,
+		Fee:      v.dex.FeeBps * 100,
+		Token0:   token0,
+		Token1:   token1,
+		Reserve0: reserve0,
+		Reserve1: reserve1,
+	}
+}
@@ -0,0 +1,119 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/blockchain/abi/gen/pairV2"
+	"grpc_cake/internal/pancakeswap"
+)
+
+var (
+	// q112 is the scale of the UQ112x112 prices accumulated by pairs.
+	q112       = new(big.Int).Lsh(big.NewInt(1), 112)
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+)
+
+// Twap averages the pair's price of tokenA over the last window seconds from the cumulative price
+// snapshots of tokenA, price0CumulativeLast or price1CumulativeLast, at the head and at the latest
+// block at or before the window's start. The mean of one price is not the inverse of the mean of the
+// other, so the one of the priced token is read. Reading the older snapshot needs a node keeping the
+// state of that block. V2 has a single pair per token pair, so fee is ignored.
+func (v *V2) Twap(ctx context.Context, tokenA, tokenB common.Address, fee uint32, window uint64) (*pancakeswap.Twap, error) {
+	to := v.client.Head()
+	if to.Number == 0 {
+		return nil, errors.New("chain head is not known yet")
+	}
+	if window == 0 || window >= to.Time {
+		return nil, fmt.Errorf("invalid TWAP window of %d seconds", window)
+	}
+
+	from, err := v.client.BlockAt(ctx, to.Time-window)
+	if err != nil {
+		return nil, err
+	}
+	if from.Time == to.Time {
+		return nil, fmt.Errorf("TWAP window of %d seconds spans no block", window)
+	}
+
+	pool, err := v.pair(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(to.Number)}, tokenA, tokenB)
+	if err != nil {
+		return nil, err
+	}
+	if pool == nil {
+		return nil, fmt.Errorf("no V2 pair of %s/%s", tokenA.Hex(), tokenB.Hex())
+	}
+
+	pair, err := pairV2.NewBlockchainCaller(pool.Address, v.client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	zeroForOne := tokenA == pool.Token0
+
+	cumulativeTo, err := priceCumulative(ctx, pair, to, zeroForOne)
+	if err != nil {
+		return nil, err
+	}
+	cumulativeFrom, err := priceCumulative(ctx, pair, from, zeroForOne)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the pair at block %d: %w", from.Number, err)
+	}
+
+	// Cumulative prices are meant to overflow, the difference is taken modulo 2^256.
+	elapsed := to.Time - from.Time
+	average := new(big.Int).Sub(cumulativeTo, cumulativeFrom)
+	average.And(average, maxUint256)
+	average.Div(average, new(big.Int).SetUint64(elapsed))
+
+	pool.Reserve0, pool.Reserve1 = nil, nil
+	twap := &pancakeswap.Twap{Pool: *pool, To: to, Window: elapsed}
+	if zeroForOne {
+		twap.Amount0, twap.Amount1 = new(big.Int).Set(q112), average
+	} else {
+		twap.Amount0, twap.Amount1 = average, new(big.Int).Set(q112)
+	}
+
+	return twap, nil
+}
+
+// priceCumulative is the pair's price0CumulativeLast, or price1CumulativeLast unless zeroForOne,
+// carried forward to the block's timestamp with the current reserves, as
+// UniswapV2OracleLibrary.currentCumulativePrices computes it.
+func priceCumulative(ctx context.Context, pair *pairV2.BlockchainCaller, block blockchain.Block, zeroForOne bool) (*big.Int, error) {
+	callOpts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block.Number)}
+
+	read := pair.Price1CumulativeLast
+	if zeroForOne {
+		read = pair.Price0CumulativeLast
+	}
+	cumulative, err := read(callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	reserves, err := pair.GetReserves(callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Timestamps are stored modulo 2^32.
+	elapsed := uint32(block.Time) - reserves.BlockTimestampLast
+	reserveIn, reserveOut := reserves.Reserve1, reserves.Reserve0
+	if zeroForOne {
+		reserveIn, reserveOut = reserves.Reserve0, reserves.Reserve1
+	}
+	if elapsed == 0 || reserveIn.Sign() == 0 {
+		return cumulative, nil
+	}
+
+	price := new(big.Int).Lsh(reserveOut, 112)
+	price.Div(price, reserveIn)
+
+	return cumulative.Add(cumulative, price.Mul(price, big.NewInt(int64(elapsed)))), nil
+}
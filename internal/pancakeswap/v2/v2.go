@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -18,72 +19,204 @@ import (
 	"grpc_cake/internal/blockchain"
 	erc20 "grpc_cake/internal/blockchain/abi/gen/erc20"
 	"grpc_cake/internal/blockchain/abi/gen/routerV2"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/registry"
 )
 
-var RouterAddresses = map[string]common.Address{
-	"eth":  common.HexToAddress("0x10ED43C718714eb63d5aA57B78B54704E256024E"), // bsc router addr
-	"bsc":  common.HexToAddress("0xEfF92A263d31888d860bD50809A8D171709b7b1c"), // eth router addr
-	"base": common.HexToAddress("0x8cFe327CEc66d1C090Dd72bd0FF11d690C33a2Eb"), // base router addr
-}
-
 type V2 struct {
+	verification  pancakeswap.Verification
+	dex           registry.Dex
 	router        *routerV2.Blockchain
+	routerABI     *abi.ABI
 	routerAddress common.Address
 	client        *blockchain.Client
+	// engine quotes from cached reserves, it is nil when the deployment has no fee configured.
+	engine *engine
 }
 
-func NewV2(client *blockchain.Client) (*V2, error) {
-	routerAddress, ok := RouterAddresses[client.Chain]
-	if !ok {
-		return nil, errors.New(fmt.Sprintf("router address not found for chain: %s", client.Chain))
-	}
+func NewV2(client *blockchain.Client, dex registry.Dex) (*V2, error) {
+	routerAddress := dex.Router
 
 	router, err := routerV2.NewBlockchain(routerAddress, client.Eth())
 	if err != nil {
 		return nil, err
 	}
 
-	return &V2{
+	routerABI, err := routerV2.BlockchainMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	v := &V2{
+		dex:           dex,
 		router:        router,
+		routerABI:     routerABI,
 		routerAddress: routerAddress,
 		client:        client,
-	}, nil
+	}
+
+	if dex.FeeBps > 0 {
+		v.engine = newEngine(client, dex.Factory, dex.FeeBps, func(ctx context.Context) (common.Address, error) {
+			return v.router.Factory(&bind.CallOpts{Context: ctx})
+		})
+	}
+
+	return v, nil
+}
+
+// Verify checks the V2 wiring against the registry once it succeeds, later calls return immediately.
+func (v *V2) Verify(ctx context.Context) error {
+	return v.verification.Ensure(ctx, v.verify)
+}
+
+// verify checks that the client serves the configured chain and that the router is deployed
+// and wired to the configured factory and wrapped native token.
+func (v *V2) verify(ctx context.Context) error {
+	if err := v.client.VerifyChainID(ctx); err != nil {
+		return err
+	}
+
+	if err := v.client.VerifyCode(ctx, "V2 router", v.routerAddress); err != nil {
+		return err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	factory, err := v.router.Factory(callOpts)
+	if err != nil {
+		return err
+	}
+	if err := v.client.ExpectAddress("V2 router factory()", factory, v.dex.Factory); err != nil {
+		return err
+	}
+
+	weth, err := v.router.WETH(callOpts)
+	if err != nil {
+		return err
+	}
+
+	return v.client.ExpectAddress("V2 router WETH()", weth, v.client.Config.WrappedNative)
 }
 
 func (v *V2) GetQuote(ctx context.Context, req *quoteswap.GetQuoteRequest) (resp *quoteswap.GetQuoteResponse, err error) {
-	amountIn := new(big.Int).SetUint64(req.Amount)
+	if err := v.Verify(ctx); err != nil {
+		return nil, err
+	}
+
+	amountIn, err := pancakeswap.AmountIn(req)
+	if err != nil {
+		return nil, err
+	}
+	path := []common.Address{common.HexToAddress(req.TokenIn), common.HexToAddress(req.TokenOut)}
+
+	logrus.Infof("Request parameters: TokenIn: %s, TokenOut: %s, Amount: %s, Slippage: %d, Chain: %s", req.TokenIn, req.TokenOut, amountIn, req.SlippageBps, req.Chain)
+
+	local, check, ok := v.localQuote(ctx, req, amountIn)
+	if ok && !check {
+		return v.quoteResponse(req, amountIn, local), nil
+	}
+
+	amountsOut, err := v.router.GetAmountsOut(pancakeswap.CallOpts(ctx, req), amountIn, path)
+	if err != nil {
+		return nil, err
+	}
+	amountOut := amountsOut[len(amountsOut)-1]
+
+	if ok {
+		v.engine.checked(path[0], path[1], local, amountOut)
+	}
+
+	return v.quoteResponse(req, amountIn, amountOut), nil
+}
+
+// localQuote quotes req from the engine's cached reserves. It only serves requests pinned to a
+// block number, which the service does for every quote, so a local quote is at the same block
+// as a router quote. check reports that the quote must be verified against the router.
+func (v *V2) localQuote(ctx context.Context, req *quoteswap.GetQuoteRequest, amountIn *big.Int) (amountOut *big.Int, check, ok bool) {
+	if v.engine == nil || req.GetBlockHash() != "" || req.GetBlockNumber() == 0 {
+		return nil, false, false
+	}
+
+	return v.engine.quote(ctx, common.HexToAddress(req.TokenIn), common.HexToAddress(req.TokenOut), amountIn, req.GetBlockNumber())
+}
+
+// QuoteCalls returns the getAmountsOut call for req, used to batch quotes through Multicall3,
+// or no call at all when the quote can be served from the local engine.
+func (v *V2) QuoteCalls(req *quoteswap.GetQuoteRequest) (*pancakeswap.QuoteCalls, error) {
+	amountIn, err := pancakeswap.AmountIn(req)
+	if err != nil {
+		return nil, err
+	}
 	path := []common.Address{common.HexToAddress(req.TokenIn), common.HexToAddress(req.TokenOut)}
 
-	logrus.Infof("Request parameters: TokenIn: %s, TokenOut: %s, Amount: %d, Slippage: %d, Chain: %s", req.TokenIn, req.TokenOut, req.Amount, req.SlippageBps, req.Chain)
+	// A checked pair cached at the request's block needs no call at all.
+	if v.engine != nil && req.GetBlockHash() == "" {
+		if local, check, ok := v.engine.cached(path[0], path[1], amountIn, req.GetBlockNumber()); ok && !check {
+			decode := func([]blockchain.CallResult) (*quoteswap.GetQuoteResponse, error) {
+				return v.quoteResponse(req, amountIn, local), nil
+			}
+			return &pancakeswap.QuoteCalls{Decode: decode}, nil
+		}
+	}
 
-	amountsOut, err := v.router.GetAmountsOut(&bind.CallOpts{Context: ctx}, amountIn, path)
+	callData, err := v.routerABI.Pack("getAmountsOut", amountIn, path)
 	if err != nil {
 		return nil, err
 	}
 
-	resp = &quoteswap.GetQuoteResponse{
+	decode := func(results []blockchain.CallResult) (*quoteswap.GetQuoteResponse, error) {
+		if len(results) != 1 || !results[0].Success {
+			return nil, errors.New("getAmountsOut reverted")
+		}
+
+		out, err := v.routerABI.Unpack("getAmountsOut", results[0].ReturnData)
+		if err != nil {
+			return nil, err
+		}
+
+		amountsOut := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+		if len(amountsOut) == 0 {
+			return nil, errors.New("getAmountsOut returned no amounts")
+		}
+
+		return v.quoteResponse(req, amountIn, amountsOut[len(amountsOut)-1]), nil
+	}
+
+	return &pancakeswap.QuoteCalls{
+		Calls:  []blockchain.Call{{Target: v.routerAddress, CallData: callData}},
+		Decode: decode,
+	}, nil
+}
+
+func (v *V2) quoteResponse(req *quoteswap.GetQuoteRequest, amountIn, amountOut *big.Int) *quoteswap.GetQuoteResponse {
+	return &quoteswap.GetQuoteResponse{
 		InputToken:  req.TokenIn,
 		InAmount:    amountIn.String(),
 		OutputToken: req.TokenOut,
-		OutAmount:   amountsOut[len(amountsOut)-1].String(),
+		OutAmount:   amountOut.String(),
 		SlippageBps: int32(req.SlippageBps),
 		Dex:         req.Dex,
 		Chain:       v.client.Chain,
+		Pool:        &quoteswap.Pool{Dex: v.dex.Name, Fee: v.dex.FeeBps * 100},
 	}
-
-	return resp, err
 }
 
 func (v *V2) ExecuteSwap(ctx context.Context, req *quoteswap.ExecuteTxRequest) (resp *quoteswap.ExecuteTxResponse, err error) {
+	if err := v.Verify(ctx); err != nil {
+		return nil, err
+	}
+
 	quote := req.QuotingResponse
 
-	amountIn := new(big.Int)
-	if _, ok := amountIn.SetString(quote.InAmount, 10); !ok {
-		return nil, errors.New("invalid amount in value")
+	amountIn, err := pancakeswap.ParseAmount(quote.InAmount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount in value: %w", err)
 	}
 
-	amountOut := new(big.Int)
-	amountOut.SetString(quote.OutAmount, 10)
+	amountOut, err := pancakeswap.ParseAmount(quote.OutAmount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount out value: %w", err)
+	}
 
 	tokenIn := common.HexToAddress(quote.InputToken)
 	tokenOut := common.HexToAddress(quote.OutputToken)
@@ -140,8 +273,8 @@ func (v *V2) ExecuteSwap(ctx context.Context, req *quoteswap.ExecuteTxRequest) (
 	resp = &quoteswap.ExecuteTxResponse{
 		TransactionHash: tx.Hash().Hex(),
 		Status:          quoteswap.TransactionStatus_PENDING,
-		SellTokenQty:    float64(amountIn.Int64()),
-		ExecutedPrice:   float64(amountOut.Int64()),
+		SellTokenQty:    pancakeswap.Float64(amountIn),
+		SellTokenAmount: amountIn.String(),
 	}
 
 	return resp, nil
@@ -189,10 +322,11 @@ func (v *V2) approveToken(ctx context.Context, tokenAddress, ownerAddress, spend
 }
 
 func (v *V2) opts(ctx context.Context) (*bind.TransactOpts, error) {
-	chainID, err := v.client.Eth().ChainID(ctx)
-	if err != nil {
+	// Refuse to sign for a node that serves another chain than the one configured.
+	if err := v.client.VerifyChainID(ctx); err != nil {
 		return nil, err
 	}
+	chainID := new(big.Int).SetUint64(v.client.Config.ChainID)
 
 	ptivateKey := os.Getenv("PRIVATE_KEY")
 	privateKeyECDSA, err := crypto.HexToECDSA(strings.TrimPrefix(ptivateKey, "0x"))
@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/blockchain/abi/gen/routerV2"
+	"grpc_cake/internal/registry"
+)
+
+func TestQuoteCallsDecode(t *testing.T) {
+	routerABI, err := routerV2.BlockchainMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &V2{
+		dex:           registry.Dex{Name: registry.DexV2, FeeBps: 25},
+		routerABI:     routerABI,
+		routerAddress: common.HexToAddress("0x10ED43C718714eb63d5aA57B78B54704E256024E"),
+		client:        &blockchain.Client{Chain: "bsc"},
+	}
+
+	amountsOut := func(amounts ...int64) []byte {
+		out := make([]*big.Int, 0, len(amounts))
+		for _, amount := range amounts {
+			out = append(out, big.NewInt(amount))
+		}
+		data, err := routerABI.Methods["getAmountsOut"].Outputs.Pack(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	tests := []struct {
+		name    string
+		results []blockchain.CallResult
+		want    string
+		wantErr bool
+	}{
+		{"last amount of the path", []blockchain.CallResult{{Success: true, ReturnData: amountsOut(1000, 987)}}, "987", false},
+		{"reverted", []blockchain.CallResult{{Success: false, ReturnData: []byte{0x08, 0xc3, 0x79, 0xa0}}}, "", true},
+		{"no amounts", []blockchain.CallResult{{Success: true, ReturnData: amountsOut()}}, "", true},
+		{"malformed return data", []blockchain.CallResult{{Success: true, ReturnData: []byte{1, 2, 3}}}, "", true},
+		{"missing result", nil, "", true},
+	}
+
+	for _, tt := range tests {
+		calls, err := v.QuoteCalls(&quoteswap.GetQuoteRequest{
+			TokenIn:  "0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c",
+			TokenOut: "0x55d398326f99059fF775485246999027B3197955",
+			AmountIn: "1000",
+			Dex:      registry.DexV2,
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if len(calls.Calls) != 1 || calls.Calls[0].Target != v.routerAddress {
+			t.Fatalf("%s: got calls %v, want one call to the router", tt.name, calls.Calls)
+		}
+
+		resp, err := calls.Decode(tt.results)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", tt.name, resp.GetOutAmount())
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if resp.GetOutAmount() != tt.want || resp.GetInAmount() != "1000" || resp.GetPool().GetFee() != 2500 {
+			t.Errorf("%s: got out %s in %s fee %d, want out %s in 1000 fee 2500", tt.name, resp.GetOutAmount(), resp.GetInAmount(), resp.GetPool().GetFee(), tt.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package v3
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// curvePoint is an amount of a curve left to the quoter, with the simulated result to check.
+type curvePoint struct {
+	curve, index int
+	fee          *big.Int
+	local        *swapResult
+	check        bool
+}
+
+// QuoteCurves quotes amountsIn through the pool of every configured fee tier at req's block. Amounts
+// are simulated where the simulator can, the others are quoted with one Multicall3 call.
+func (v *V3) QuoteCurves(ctx context.Context, req *quoteswap.GetQuoteRequest, amountsIn []*big.Int) ([]pancakeswap.Curve, error) {
+	if err := v.Verify(ctx); err != nil {
+		return nil, err
+	}
+
+	tokenIn, tokenOut := common.HexToAddress(req.TokenIn), common.HexToAddress(req.TokenOut)
+
+	var (
+		curves []pancakeswap.Curve
+		points []curvePoint
+		calls  []blockchain.Call
+	)
+	for _, fee := range v.feeTiers {
+		pool, err := v.QuotePool(ctx, req, uint32(fee.Uint64()))
+		if err != nil {
+			return nil, err
+		}
+		if pool == nil || pool.Liquidity == nil || pool.Liquidity.Sign() == 0 {
+			continue
+		}
+
+		curve := pancakeswap.Curve{Pool: *pool, AmountsOut: make([]*big.Int, len(amountsIn))}
+		for i, amountIn := range amountsIn {
+			local, check, ok := v.localQuote(ctx, req, tokenIn, tokenOut, fee, amountIn)
+			if ok && !check {
+				if local != nil {
+					curve.AmountsOut[i] = local.amountOut
+				}
+				continue
+			}
+
+			callData, err := v.quoterV2ABI.Pack("quoteExactInput", encodePath(tokenIn, fee, tokenOut), amountIn)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, blockchain.Call{Target: v.quoterV2Address, CallData: callData})
+			points = append(points, curvePoint{curve: len(curves), index: i, fee: fee, local: local, check: ok})
+		}
+		curves = append(curves, curve)
+	}
+
+	if len(calls) == 0 {
+		return curves, nil
+	}
+
+	logrus.Debugf("Quoting %d V3 curve points of %s/%s on %s with the quoter", len(calls), tokenIn.Hex(), tokenOut.Hex(), v.client.Chain)
+
+	results, err := v.client.Multicall(ctx, pancakeswap.CallOpts(ctx, req), calls)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, point := range points {
+		var quoted *swapResult
+		if results[i].Success {
+			if out, err := v.quoterV2ABI.Unpack("quoteExactInput", results[i].ReturnData); err == nil {
+				quoted = newSwapResult(
+					abi.ConvertType(out[0], new(big.Int)).(*big.Int),
+					*abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int),
+					*abi.ConvertType(out[2], new([]uint32)).(*[]uint32),
+				)
+				curves[point.curve].AmountsOut[point.index] = quoted.amountOut
+			}
+		}
+		if point.check {
+			v.simulator.checked(tokenIn, tokenOut, point.fee, point.local, quoted)
+		}
+	}
+
+	return curves, nil
+}
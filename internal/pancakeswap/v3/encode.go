@@ -1,7 +1,10 @@
 package v3
 
 import (
+	"bytes"
 	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var (
@@ -39,3 +42,12 @@ func PadBytes(b []byte, size int, left bool) []byte {
 	}
 	return tmp
 }
+
+// encodePath packs a single-hop swap path as tokenIn | fee (uint24) | tokenOut.
+func encodePath(tokenIn common.Address, fee *big.Int, tokenOut common.Address) []byte {
+	return bytes.Join([][]byte{
+		tokenIn.Bytes(),
+		EncodeUint256(fee, 3, true),
+		tokenOut.Bytes(),
+	}, nil)
+}
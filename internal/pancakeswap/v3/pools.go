@@ -0,0 +1,98 @@
+package v3
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain/abi/gen/factoryV3"
+	"grpc_cake/internal/blockchain/abi/gen/poolV3"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// Pools returns the pool of tokenA and tokenB of every configured fee tier the factory has
+// created, with its liquidity and price.
+func (v *V3) Pools(ctx context.Context, tokenA, tokenB common.Address) ([]pancakeswap.Pool, error) {
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	var pools []pancakeswap.Pool
+	for _, fee := range v.feeTiers {
+		pool, err := v.pool(callOpts, tokenA, tokenB, fee)
+		if err != nil {
+			return nil, err
+		}
+		if pool != nil {
+			pools = append(pools, *pool)
+		}
+	}
+
+	return pools, nil
+}
+
+// QuotePool returns the pool of fee a quote of req went through, from the simulator's state when
+// it holds the pool at req's block.
+func (v *V3) QuotePool(ctx context.Context, req *quoteswap.GetQuoteRequest, fee uint32) (*pancakeswap.Pool, error) {
+	tokenIn, tokenOut := common.HexToAddress(req.TokenIn), common.HexToAddress(req.TokenOut)
+	feeTier := new(big.Int).SetUint64(uint64(fee))
+
+	if req.GetBlockHash() == "" {
+		if state := v.simulator.snapshot(tokenIn, tokenOut, feeTier, req.GetBlockNumber()); state != nil {
+			key := newPoolKey(tokenIn, tokenOut, feeTier)
+			return v.poolOf(state.address, fee, key.token0, key.token1, state.liquidity, state.sqrtPriceX96, state.tick), nil
+		}
+	}
+
+	return v.pool(pancakeswap.CallOpts(ctx, req), tokenIn, tokenOut, feeTier)
+}
+
+// pool reads the pool of tokenA and tokenB of fee with its in-range state, nil when the factory
+// has not created it.
+func (v *V3) pool(callOpts *bind.CallOpts, tokenA, tokenB common.Address, fee *big.Int) (*pancakeswap.Pool, error) {
+	poolAddress, err := v.poolAddress(callOpts, tokenA, tokenB, fee)
+	if err != nil || poolAddress == (common.Address{}) {
+		return nil, err
+	}
+
+	pool, err := poolV3.NewBlockchainCaller(poolAddress, v.client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	slot0, err := pool.Slot0(callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidity, err := pool.Liquidity(callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	key := newPoolKey(tokenA, tokenB, fee)
+	return v.poolOf(poolAddress, key.fee, key.token0, key.token1, liquidity, slot0.SqrtPriceX96, int32(slot0.Tick.Int64())), nil
+}
+
+// poolAddress returns the pool of tokenA and tokenB of fee, the zero address when it does not exist.
+func (v *V3) poolAddress(callOpts *bind.CallOpts, tokenA, tokenB common.Address, fee *big.Int) (common.Address, error) {
+	factory, err := factoryV3.NewBlockchainCaller(v.dex.Factory, v.client.Eth())
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return factory.GetPool(callOpts, tokenA, tokenB, fee)
+}
+
+func (v *V3) poolOf(address common.Address, fee uint32, token0, token1 common.Address, liquidity, sqrtPriceX96 *big.Int, tick int32) *pancakeswap.Pool {
+	return &pancakeswap.Pool{
+		Dex:          v.dex.Name,
+		Address:      address,
+		Fee:          fee,
+		Token0:       token0,
+		Token1:       token1,
+		Liquidity:    liquidity,
+		SqrtPriceX96: sqrtPriceX96,
+		Tick:         tick,
+	}
+}
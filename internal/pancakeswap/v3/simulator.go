@@ -0,0 +1,507 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/blockchain/abi/gen/factoryV3"
+	"grpc_cake/internal/blockchain/abi/gen/poolV3"
+)
+
+const (
+	// maxSwapSteps bounds the steps of a simulated swap, each crossing a tick or a bitmap word.
+	// Longer swaps, typically through empty price ranges, are left to the quoter.
+	maxSwapSteps = 128
+	// checkEvery is how often a simulated quote is checked against the quoter, besides the
+	// quotes of every pool until one matched.
+	checkEvery = 100
+)
+
+var errSwapSteps = errors.New("swap exceeds the simulated steps")
+
+// swapResult is the outcome of an exact input swap through one pool, as QuoterV2 reports it.
+type swapResult struct {
+	amountOut               *big.Int
+	sqrtPriceX96After       *big.Int
+	initializedTicksCrossed uint32
+}
+
+// simulator quotes V3 swaps off-chain from the pools' slot0, liquidity and initialized ticks.
+// Pool state is read at the head block on a pool's first quote in that block and dropped on the
+// next head, bitmap words and ticks are read as swaps reach them.
+type simulator struct {
+	client  *blockchain.Client
+	factory *factoryV3.BlockchainCaller
+
+	mu      sync.Mutex
+	pools   map[poolKey]*pool
+	state   map[poolKey]*poolState
+	matched map[poolKey]bool
+	quotes  uint64
+}
+
+type poolKey struct {
+	token0, token1 common.Address
+	fee            uint32
+}
+
+// pool is the immutable part of a pool.
+type pool struct {
+	address     common.Address
+	caller      *poolV3.BlockchainCaller
+	fee         uint32
+	tickSpacing int32
+}
+
+// poolState is a pool at block. Its mutex serializes swaps, which fill words and ticks.
+type poolState struct {
+	*pool
+	block        uint64
+	sqrtPriceX96 *big.Int
+	tick         int32
+	liquidity    *big.Int
+
+	mu    sync.Mutex
+	words map[int16]*big.Int
+	ticks map[int32]*big.Int
+}
+
+func newSimulator(client *blockchain.Client, factoryAddress common.Address) (*simulator, error) {
+	factory, err := factoryV3.NewBlockchainCaller(factoryAddress, client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	s := &simulator{
+		client:  client,
+		factory: factory,
+		pools:   make(map[poolKey]*pool),
+		state:   make(map[poolKey]*poolState),
+		matched: make(map[poolKey]bool),
+	}
+	client.OnNewHead(s.onHead)
+
+	return s, nil
+}
+
+// quote simulates swapping amountIn through the pool of fee at block. check reports whether the
+// result should be compared with the quoter. ok is false when the swap cannot be simulated, the
+// caller then falls back to the quoter. A nil result with ok set means the quoter would revert
+// as well, because the pool does not exist or cannot swap any of amountIn.
+func (s *simulator) quote(ctx context.Context, tokenIn, tokenOut common.Address, fee *big.Int, amountIn *big.Int, block uint64) (result *swapResult, check, ok bool) {
+	// Past blocks are quoted by the quoter, only the head is worth loading.
+	if amountIn.Sign() <= 0 || block == 0 || block != s.client.Head().Number {
+		return nil, false, false
+	}
+
+	key := newPoolKey(tokenIn, tokenOut, fee)
+
+	state, err := s.load(ctx, key, block)
+	if err != nil {
+		logrus.Debugf("failed to load V3 pool %s/%s/%d on %s: %v", key.token0.Hex(), key.token1.Hex(), key.fee, s.client.Chain, err)
+		return nil, false, false
+	}
+
+	if state != nil {
+		if result, err = state.swap(ctx, tokenIn == key.token0, amountIn); err != nil {
+			logrus.Debugf("failed to simulate swap through V3 pool %s on %s: %v", state.address.Hex(), s.client.Chain, err)
+			return nil, false, false
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.quotes++
+	return result, !s.matched[key] || s.quotes%checkEvery == 0, true
+}
+
+// checked records the outcome of comparing a simulated quote with the quoter, a nil result
+// stands for a reverted quote. A mismatch drops the pool state, so it is reloaded and checked again.
+func (s *simulator) checked(tokenIn, tokenOut common.Address, fee *big.Int, local, quoter *swapResult) {
+	key := newPoolKey(tokenIn, tokenOut, fee)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !local.equal(quoter) {
+		logrus.Errorf("simulated V3 quote of %s/%s/%d on %s is %s, quoter quotes %s, dropping pool state",
+			key.token0.Hex(), key.token1.Hex(), key.fee, s.client.Chain, local, quoter)
+		delete(s.state, key)
+		delete(s.matched, key)
+		return
+	}
+	s.matched[key] = true
+}
+
+// load returns the state of the pool of key at block, nil when the factory has no such pool.
+func (s *simulator) load(ctx context.Context, key poolKey, block uint64) (*poolState, error) {
+	s.mu.Lock()
+	state, p := s.state[key], s.pools[key]
+	s.mu.Unlock()
+
+	if state != nil && state.block == block {
+		return state, nil
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}
+
+	if p == nil {
+		address, err := s.factory.GetPool(callOpts, key.token0, key.token1, new(big.Int).SetUint64(uint64(key.fee)))
+		if err != nil {
+			return nil, err
+		}
+		// Missing pools are looked up again on every quote, they may be created later.
+		if address == (common.Address{}) {
+			return nil, nil
+		}
+
+		caller, err := poolV3.NewBlockchainCaller(address, s.client.Eth())
+		if err != nil {
+			return nil, err
+		}
+
+		tickSpacing, err := caller.TickSpacing(callOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		p = &pool{address: address, caller: caller, fee: key.fee, tickSpacing: int32(tickSpacing.Int64())}
+	}
+
+	slot0, err := p.caller.Slot0(callOpts)
+	if err != nil {
+		return nil, err
+	}
+	// A pool that was never initialized is locked and reverts every swap.
+	if !slot0.Unlocked {
+		return nil, nil
+	}
+
+	liquidity, err := p.caller.Liquidity(callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	state = &poolState{
+		pool:         p,
+		block:        block,
+		sqrtPriceX96: slot0.SqrtPriceX96,
+		tick:         int32(slot0.Tick.Int64()),
+		liquidity:    liquidity,
+		words:        make(map[int16]*big.Int),
+		ticks:        make(map[int32]*big.Int),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pools[key] = p
+	// A concurrent load may have won the race, keep the state other swaps already filled.
+	if existing := s.state[key]; existing != nil && existing.block >= block {
+		return existing, nil
+	}
+	s.state[key] = state
+
+	return state, nil
+}
+
+// snapshot returns the state of the pool of the tokens and fee when it is loaded at block.
+func (s *simulator) snapshot(tokenA, tokenB common.Address, fee *big.Int, block uint64) *poolState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.state[newPoolKey(tokenA, tokenB, fee)]
+	if state == nil || state.block != block || block == 0 {
+		return nil
+	}
+
+	return state
+}
+
+// onHead drops the pool states of older blocks.
+func (s *simulator) onHead(head blockchain.Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, state := range s.state {
+		if state.block < head.Number {
+			delete(s.state, key)
+		}
+	}
+}
+
+// swap is UniswapV3Pool.swap for an exact input of amountIn without a price limit, as the quoter
+// runs it. It returns nil when no input can be swapped, which makes the quoter revert.
+func (p *poolState) swap(ctx context.Context, zeroForOne bool, amountIn *big.Int) (*swapResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sqrtPriceLimit := new(big.Int)
+	if zeroForOne {
+		sqrtPriceLimit.Add(MinSqrtRatio, big.NewInt(1))
+	} else {
+		sqrtPriceLimit.Sub(MaxSqrtRatio, big.NewInt(1))
+	}
+
+	var (
+		remaining    = new(big.Int).Set(amountIn)
+		amountOut    = new(big.Int)
+		sqrtPriceX96 = p.sqrtPriceX96
+		tick         = p.tick
+		liquidity    = new(big.Int).Set(p.liquidity)
+	)
+
+	for steps := 0; remaining.Sign() != 0 && sqrtPriceX96.Cmp(sqrtPriceLimit) != 0; steps++ {
+		if steps == maxSwapSteps {
+			return nil, errSwapSteps
+		}
+
+		tickNext, initialized, err := p.nextInitializedTick(ctx, tick, zeroForOne)
+		if err != nil {
+			return nil, err
+		}
+		tickNext = max(MinTick, min(MaxTick, tickNext))
+
+		sqrtPriceNext, err := GetSqrtRatioAtTick(tickNext)
+		if err != nil {
+			return nil, err
+		}
+
+		target := sqrtPriceNext
+		if zeroForOne && sqrtPriceNext.Cmp(sqrtPriceLimit) < 0 || !zeroForOne && sqrtPriceNext.Cmp(sqrtPriceLimit) > 0 {
+			target = sqrtPriceLimit
+		}
+
+		step, err := computeSwapStep(sqrtPriceX96, target, liquidity, remaining, p.fee)
+		if err != nil {
+			return nil, err
+		}
+
+		remaining.Sub(remaining, step.amountIn).Sub(remaining, step.feeAmount)
+		amountOut.Add(amountOut, step.amountOut)
+
+		switch {
+		case step.sqrtRatioNext.Cmp(sqrtPriceNext) == 0:
+			if initialized {
+				liquidityNet, err := p.liquidityNet(ctx, tickNext)
+				if err != nil {
+					return nil, err
+				}
+				if zeroForOne {
+					liquidity.Sub(liquidity, liquidityNet)
+				} else {
+					liquidity.Add(liquidity, liquidityNet)
+				}
+				if liquidity.Sign() < 0 {
+					return nil, errors.New("liquidity underflow crossing a tick")
+				}
+			}
+
+			tick = tickNext
+			if zeroForOne {
+				tick--
+			}
+		case step.sqrtRatioNext.Cmp(sqrtPriceX96) != 0:
+			if tick, err = GetTickAtSqrtRatio(step.sqrtRatioNext); err != nil {
+				return nil, err
+			}
+		}
+		sqrtPriceX96 = step.sqrtRatioNext
+	}
+
+	if remaining.Cmp(amountIn) == 0 {
+		return nil, nil
+	}
+
+	crossed, err := p.countInitializedTicksCrossed(ctx, p.tick, tick)
+	if err != nil {
+		return nil, err
+	}
+
+	return &swapResult{amountOut: amountOut, sqrtPriceX96After: sqrtPriceX96, initializedTicksCrossed: crossed}, nil
+}
+
+// nextInitializedTick is TickBitmap.nextInitializedTickWithinOneWord: the next initialized tick
+// at or below tick when lte is set and above it otherwise, or the word's last tick when there is none.
+func (p *poolState) nextInitializedTick(ctx context.Context, tick int32, lte bool) (int32, bool, error) {
+	compressed := tick / p.tickSpacing
+	if tick < 0 && tick%p.tickSpacing != 0 {
+		compressed--
+	}
+
+	if lte {
+		wordPos, bitPos := position(compressed)
+		word, err := p.word(ctx, wordPos)
+		if err != nil {
+			return 0, false, err
+		}
+
+		mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bitPos)+1), big.NewInt(1))
+		masked := mask.And(mask, word)
+		if masked.Sign() != 0 {
+			return (compressed - int32(bitPos) + int32(masked.BitLen()-1)) * p.tickSpacing, true, nil
+		}
+
+		return (compressed - int32(bitPos)) * p.tickSpacing, false, nil
+	}
+
+	wordPos, bitPos := position(compressed + 1)
+	word, err := p.word(ctx, wordPos)
+	if err != nil {
+		return 0, false, err
+	}
+
+	masked := new(big.Int).Rsh(word, uint(bitPos))
+	if masked.Sign() != 0 {
+		return (compressed + 1 + int32(masked.TrailingZeroBits())) * p.tickSpacing, true, nil
+	}
+
+	return (compressed + 1 + int32(255-bitPos)) * p.tickSpacing, false, nil
+}
+
+// countInitializedTicksCrossed is PoolTicksCounter.countInitializedTicksCrossed, which QuoterV2
+// reports. It divides ticks with truncation rather than flooring and counts the edge ticks
+// depending on the swap direction, which is kept as is.
+func (p *poolState) countInitializedTicksCrossed(ctx context.Context, tickBefore, tickAfter int32) (uint32, error) {
+	wordPos, bitPos := position(tickBefore / p.tickSpacing)
+	wordPosAfter, bitPosAfter := position(tickAfter / p.tickSpacing)
+
+	word, err := p.word(ctx, wordPos)
+	if err != nil {
+		return 0, err
+	}
+	wordAfter, err := p.word(ctx, wordPosAfter)
+	if err != nil {
+		return 0, err
+	}
+
+	// An initialized tickAfter is only crossed when swapping down, an initialized tickBefore
+	// only when swapping up.
+	tickAfterInitialized := wordAfter.Bit(int(bitPosAfter)) == 1 && tickAfter%p.tickSpacing == 0 && tickBefore > tickAfter
+	tickBeforeInitialized := word.Bit(int(bitPos)) == 1 && tickBefore%p.tickSpacing == 0 && tickBefore < tickAfter
+
+	wordPosLower, bitPosLower, wordPosHigher, bitPosHigher := wordPos, bitPos, wordPosAfter, bitPosAfter
+	if wordPos > wordPosAfter || wordPos == wordPosAfter && bitPos > bitPosAfter {
+		wordPosLower, bitPosLower, wordPosHigher, bitPosHigher = wordPosAfter, bitPosAfter, wordPos, bitPos
+	}
+
+	var crossed uint32
+	for pos := int32(wordPosLower); pos <= int32(wordPosHigher); pos++ {
+		word, err := p.word(ctx, int16(pos))
+		if err != nil {
+			return 0, err
+		}
+
+		masked := new(big.Int).Set(word)
+		if pos == int32(wordPosLower) {
+			masked.Rsh(masked, uint(bitPosLower))
+			masked.Lsh(masked, uint(bitPosLower))
+		}
+		if pos == int32(wordPosHigher) {
+			high := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bitPosHigher)+1), big.NewInt(1))
+			masked.And(masked, high)
+		}
+
+		for _, w := range masked.Bits() {
+			crossed += uint32(bits.OnesCount(uint(w)))
+		}
+	}
+
+	if tickAfterInitialized {
+		crossed--
+	}
+	if tickBeforeInitialized {
+		crossed--
+	}
+
+	return crossed, nil
+}
+
+// word returns the tick bitmap word at pos, read from the pool on first use.
+func (p *poolState) word(ctx context.Context, pos int16) (*big.Int, error) {
+	if word, ok := p.words[pos]; ok {
+		return word, nil
+	}
+
+	word, err := p.caller.TickBitmap(p.callOpts(ctx), pos)
+	if err != nil {
+		return nil, err
+	}
+	p.words[pos] = word
+
+	return word, nil
+}
+
+// liquidityNet returns the liquidity added when crossing tick upwards, read from the pool on first use.
+func (p *poolState) liquidityNet(ctx context.Context, tick int32) (*big.Int, error) {
+	if liquidityNet, ok := p.ticks[tick]; ok {
+		return liquidityNet, nil
+	}
+
+	info, err := p.caller.Ticks(p.callOpts(ctx), big.NewInt(int64(tick)))
+	if err != nil {
+		return nil, err
+	}
+	p.ticks[tick] = info.LiquidityNet
+
+	return info.LiquidityNet, nil
+}
+
+func (p *poolState) callOpts(ctx context.Context) *bind.CallOpts {
+	return &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(p.block)}
+}
+
+// position is TickBitmap.position, the word and bit of a compressed tick.
+func position(compressed int32) (wordPos int16, bitPos uint8) {
+	return int16(compressed >> 8), uint8(compressed & 0xff)
+}
+
+func newPoolKey(tokenA, tokenB common.Address, fee *big.Int) poolKey {
+	if tokenB.Cmp(tokenA) < 0 {
+		tokenA, tokenB = tokenB, tokenA
+	}
+
+	return poolKey{token0: tokenA, token1: tokenB, fee: uint32(fee.Uint64())}
+}
+
+// newSwapResult builds the result of a single pool quote from the quoter's per pool lists.
+func newSwapResult(amountOut *big.Int, sqrtPriceX96AfterList []*big.Int, initializedTicksCrossedList []uint32) *swapResult {
+	result := &swapResult{amountOut: amountOut}
+	if len(sqrtPriceX96AfterList) > 0 {
+		result.sqrtPriceX96After = sqrtPriceX96AfterList[0]
+	}
+	if len(initializedTicksCrossedList) > 0 {
+		result.initializedTicksCrossed = initializedTicksCrossedList[0]
+	}
+
+	return result
+}
+
+// equal reports whether two quotes match, nil standing for a reverted quote.
+func (r *swapResult) equal(other *swapResult) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+
+	return r.amountOut.Cmp(other.amountOut) == 0 &&
+		r.sqrtPriceX96After != nil && other.sqrtPriceX96After != nil &&
+		r.sqrtPriceX96After.Cmp(other.sqrtPriceX96After) == 0 &&
+		r.initializedTicksCrossed == other.initializedTicksCrossed
+}
+
+func (r *swapResult) String() string {
+	if r == nil {
+		return "a revert"
+	}
+
+	return fmt.Sprintf("%s (sqrt price %s, %d ticks crossed)", r.amountOut, r.sqrtPriceX96After, r.initializedTicksCrossed)
+}
@@ -0,0 +1,105 @@
+package v3
+
+import (
+	"context"
+	"math/big"
+	"testing"
+)
+
+// fixtureTicks is the liquidityNet of the initialized ticks of a fee 500 pool with tick spacing
+// 10 and positions of 5e18 on [-3000, 3000], 2e18 on [-600, 400], 3e18 on [-100, 200] and 1e18 on
+// [2600, 5200]. The ticks span bitmap words -2 to 2.
+var fixtureTicks = map[int32]string{
+	-3000: "5000000000000000000",
+	-600:  "2000000000000000000",
+	-100:  "3000000000000000000",
+	200:   "-3000000000000000000",
+	400:   "-2000000000000000000",
+	2600:  "1000000000000000000",
+	3000:  "-5000000000000000000",
+	5200:  "-1000000000000000000",
+}
+
+// fixturePool returns the fixture pool at sqrtPriceX96, with every bitmap word and tick preloaded
+// so that swaps make no calls.
+func fixturePool(t *testing.T, sqrtPriceX96 string, tick int32, liquidity string) *poolState {
+	t.Helper()
+
+	p := &poolState{
+		pool:         &pool{fee: 500, tickSpacing: 10},
+		sqrtPriceX96: bigInt(t, sqrtPriceX96),
+		tick:         tick,
+		liquidity:    bigInt(t, liquidity),
+		words:        make(map[int16]*big.Int),
+		ticks:        make(map[int32]*big.Int),
+	}
+	for pos := int16(-8); pos <= 8; pos++ {
+		p.words[pos] = new(big.Int)
+	}
+	for tick, liquidityNet := range fixtureTicks {
+		wordPos, bitPos := position(tick / p.tickSpacing)
+		p.words[wordPos].SetBit(p.words[wordPos], int(bitPos), 1)
+		p.ticks[tick] = bigInt(t, liquidityNet)
+	}
+
+	return p
+}
+
+// TestPoolSwap checks that simulated swaps report what QuoterV2 does for the fixture pool. The
+// expected amountOut, sqrtPriceX96After and initializedTicksCrossed were not read from a chain but
+// computed from the same pool state by a separate port of UniswapV3Pool.swap and
+// PoolTicksCounter.countInitializedTicksCrossed with Solidity integer semantics.
+func TestPoolSwap(t *testing.T) {
+	const (
+		// Within tick 37, with the three positions around it active.
+		insideSqrtPrice = "79376185817380023155983197135"
+		insideLiquidity = "10000000000000000000"
+		// Exactly at the initialized tick 200, where the [-100, 200] position is inactive.
+		atTickSqrtPrice = "80024378775772204256025656563"
+		atTickLiquidity = "7000000000000000000"
+	)
+
+	tests := []struct {
+		name         string
+		sqrtPriceX96 string
+		tick         int32
+		liquidity    string
+		zeroForOne   bool
+		amountIn     string
+
+		wantOut        string
+		wantSqrtPrice  string
+		wantTicksCross uint32
+	}{
+		{"zero for one within the range", insideSqrtPrice, 37, insideLiquidity, true, "1000000000000000",
+			"1003137803098267", "79368238140891215995492505890", 0},
+		{"zero for one crossing -100", insideSqrtPrice, 37, insideLiquidity, true, "200000000000000000",
+			"196004621856501433", "77390525971912268339690198666", 1},
+		{"zero for one crossing -100 and -600", insideSqrtPrice, 37, insideLiquidity, true, "700000000000000000",
+			"632494565677246330", "70675584413484263793857865363", 2},
+		{"one for zero within the range", insideSqrtPrice, 37, insideLiquidity, false, "1000000000000000",
+			"995676343124006", "79384104672223323876525671852", 0},
+		{"one for zero crossing 200 and 400", insideSqrtPrice, 37, insideLiquidity, false, "300000000000000000",
+			"287066928348317375", "83157618578612724675722737311", 2},
+		{"one for zero crossing into the next word", insideSqrtPrice, 37, insideLiquidity, false, "800000000000000000",
+			"701324652099577107", "90934843483857856946289047055", 3},
+		{"zero for one from an initialized tick", atTickSqrtPrice, 200, atTickLiquidity, true, "50000000000000000",
+			"50728447824410607", "79622466604939325980617402175", 1},
+		{"one for zero from an initialized tick", atTickSqrtPrice, 200, atTickLiquidity, false, "50000000000000000",
+			"48641666118674631", "80590011264579398580488136408", 0},
+	}
+
+	for _, tt := range tests {
+		p := fixturePool(t, tt.sqrtPriceX96, tt.tick, tt.liquidity)
+
+		got, err := p.swap(context.Background(), tt.zeroForOne, bigInt(t, tt.amountIn))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		want := &swapResult{amountOut: bigInt(t, tt.wantOut), sqrtPriceX96After: bigInt(t, tt.wantSqrtPrice), initializedTicksCrossed: tt.wantTicksCross}
+		if !got.equal(want) {
+			t.Errorf("%s: got %s, want %s", tt.name, got, want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package v3
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errSqrtPrice = errors.New("sqrt price overflows or underflows")
+
+// mulDiv is FullMath.mulDiv, floor(a*b/denominator).
+func mulDiv(a, b, denominator *big.Int) *big.Int {
+	product := new(big.Int).Mul(a, b)
+	return product.Div(product, denominator)
+}
+
+// mulDivRoundingUp is FullMath.mulDivRoundingUp, ceil(a*b/denominator).
+func mulDivRoundingUp(a, b, denominator *big.Int) *big.Int {
+	return divRoundingUp(new(big.Int).Mul(a, b), denominator)
+}
+
+// divRoundingUp is UnsafeMath.divRoundingUp, ceil(x/y) for positive operands.
+func divRoundingUp(x, y *big.Int) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(x, y, new(big.Int))
+	if remainder.Sign() != 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+
+	return quotient
+}
+
+// getNextSqrtPriceFromAmount0RoundingUp is the sqrt price after adding or removing amount of
+// token0, rounded up like SqrtPriceMath so the price never moves further than the amount allows.
+func getNextSqrtPriceFromAmount0RoundingUp(sqrtPX96, liquidity, amount *big.Int, add bool) (*big.Int, error) {
+	if amount.Sign() == 0 {
+		return sqrtPX96, nil
+	}
+
+	numerator1 := new(big.Int).Lsh(liquidity, 96)
+	product := new(big.Int).Mul(amount, sqrtPX96)
+
+	if add {
+		// The contract only takes the precise path when neither the product nor the denominator
+		// overflow 256 bits, the fallback rounds differently so the same split is kept here.
+		if product.Cmp(maxUint256) <= 0 {
+			denominator := new(big.Int).Add(numerator1, product)
+			if denominator.Cmp(maxUint256) <= 0 {
+				return mulDivRoundingUp(numerator1, sqrtPX96, denominator), nil
+			}
+		}
+
+		denominator := new(big.Int).Div(numerator1, sqrtPX96)
+		return divRoundingUp(numerator1, denominator.Add(denominator, amount)), nil
+	}
+
+	if product.Cmp(maxUint256) > 0 || numerator1.Cmp(product) <= 0 {
+		return nil, errSqrtPrice
+	}
+	next := mulDivRoundingUp(numerator1, sqrtPX96, new(big.Int).Sub(numerator1, product))
+	if next.Cmp(maxUint160) > 0 {
+		return nil, errSqrtPrice
+	}
+
+	return next, nil
+}
+
+// getNextSqrtPriceFromAmount1RoundingDown is the sqrt price after adding or removing amount of
+// token1, rounded down like SqrtPriceMath.
+func getNextSqrtPriceFromAmount1RoundingDown(sqrtPX96, liquidity, amount *big.Int, add bool) (*big.Int, error) {
+	if add {
+		next := new(big.Int).Add(sqrtPX96, mulDiv(amount, q96, liquidity))
+		if next.Cmp(maxUint160) > 0 {
+			return nil, errSqrtPrice
+		}
+		return next, nil
+	}
+
+	quotient := mulDivRoundingUp(amount, q96, liquidity)
+	if sqrtPX96.Cmp(quotient) <= 0 {
+		return nil, errSqrtPrice
+	}
+
+	return quotient.Sub(sqrtPX96, quotient), nil
+}
+
+// getNextSqrtPriceFromInput is the sqrt price after swapping amountIn into the pool.
+func getNextSqrtPriceFromInput(sqrtPX96, liquidity, amountIn *big.Int, zeroForOne bool) (*big.Int, error) {
+	if sqrtPX96.Sign() <= 0 || liquidity.Sign() <= 0 {
+		return nil, errSqrtPrice
+	}
+
+	if zeroForOne {
+		return getNextSqrtPriceFromAmount0RoundingUp(sqrtPX96, liquidity, amountIn, true)
+	}
+
+	return getNextSqrtPriceFromAmount1RoundingDown(sqrtPX96, liquidity, amountIn, true)
+}
+
+// getAmount0Delta is the amount of token0 between two sqrt prices at liquidity.
+func getAmount0Delta(sqrtRatioA, sqrtRatioB, liquidity *big.Int, roundUp bool) *big.Int {
+	if sqrtRatioA.Cmp(sqrtRatioB) > 0 {
+		sqrtRatioA, sqrtRatioB = sqrtRatioB, sqrtRatioA
+	}
+
+	numerator1 := new(big.Int).Lsh(liquidity, 96)
+	numerator2 := new(big.Int).Sub(sqrtRatioB, sqrtRatioA)
+
+	if roundUp {
+		return divRoundingUp(mulDivRoundingUp(numerator1, numerator2, sqrtRatioB), sqrtRatioA)
+	}
+
+	amount := mulDiv(numerator1, numerator2, sqrtRatioB)
+	return amount.Div(amount, sqrtRatioA)
+}
+
+// getAmount1Delta is the amount of token1 between two sqrt prices at liquidity.
+func getAmount1Delta(sqrtRatioA, sqrtRatioB, liquidity *big.Int, roundUp bool) *big.Int {
+	if sqrtRatioA.Cmp(sqrtRatioB) > 0 {
+		sqrtRatioA, sqrtRatioB = sqrtRatioB, sqrtRatioA
+	}
+
+	delta := new(big.Int).Sub(sqrtRatioB, sqrtRatioA)
+	if roundUp {
+		return mulDivRoundingUp(liquidity, delta, q96)
+	}
+
+	return mulDiv(liquidity, delta, q96)
+}
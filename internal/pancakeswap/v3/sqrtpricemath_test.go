@@ -0,0 +1,71 @@
+package v3
+
+import (
+	"math/big"
+	"testing"
+)
+
+// maxUint256Half is MaxUint256 / 2.
+const maxUint256Half = "57896044618658097711785492504343953926634992332820282019728792003956564819967"
+
+// Vectors from Uniswap v3-core test/SqrtPriceMath.spec.ts. encodePriceSqrt(1, 1) is q96 and
+// encodePriceSqrt(121, 100) is 87150978765690771352898345369.
+func TestGetNextSqrtPriceFromInput(t *testing.T) {
+	tests := []struct {
+		name                        string
+		sqrtPriceX96, liquidity, in string
+		zeroForOne                  bool
+		want                        string
+	}{
+		{"zero amount, zero for one", "79228162514264337593543950336", "100000000000000000", "0", true, "79228162514264337593543950336"},
+		{"zero amount, one for zero", "79228162514264337593543950336", "100000000000000000", "0", false, "79228162514264337593543950336"},
+		{"0.1 token1", "79228162514264337593543950336", "1000000000000000000", "100000000000000000", false, "87150978765690771352898345369"},
+		{"0.1 token0", "79228162514264337593543950336", "1000000000000000000", "100000000000000000", true, "72025602285694852357767227579"},
+		{"amount in above uint96 max", "79228162514264337593543950336", "10000000000000000000", "1267650600228229401496703205376", true, "624999999995069620"},
+		{"returns 1 with enough amount in", "79228162514264337593543950336", "1", maxUint256Half, true, "1"},
+		{"any amount in cannot underflow the price", "1", "1", "57896044618658097711785492504343953926634992332820282019728792003956564819968", true, "1"},
+	}
+
+	for _, tt := range tests {
+		got, err := getNextSqrtPriceFromInput(bigInt(t, tt.sqrtPriceX96), bigInt(t, tt.liquidity), bigInt(t, tt.in), tt.zeroForOne)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := getNextSqrtPriceFromInput(new(big.Int), big.NewInt(1), big.NewInt(1), true); err == nil {
+		t.Error("zero price: expected an error")
+	}
+	if _, err := getNextSqrtPriceFromInput(big.NewInt(1), new(big.Int), big.NewInt(1), true); err == nil {
+		t.Error("zero liquidity: expected an error")
+	}
+}
+
+func TestGetAmountDelta(t *testing.T) {
+	price, price121 := bigInt(t, "79228162514264337593543950336"), bigInt(t, "87150978765690771352898345369")
+	liquidity := bigInt(t, "1000000000000000000")
+
+	tests := []struct {
+		name string
+		got  *big.Int
+		want string
+	}{
+		{"amount0 rounded up", getAmount0Delta(price, price121, liquidity, true), "90909090909090910"},
+		{"amount0 rounded down", getAmount0Delta(price, price121, liquidity, false), "90909090909090909"},
+		{"amount0 with swapped prices", getAmount0Delta(price121, price, liquidity, true), "90909090909090910"},
+		{"amount0 of equal prices", getAmount0Delta(price, price, liquidity, true), "0"},
+		{"amount0 of zero liquidity", getAmount0Delta(price, price121, new(big.Int), true), "0"},
+		{"amount1 rounded up", getAmount1Delta(price, price121, liquidity, true), "100000000000000000"},
+		{"amount1 rounded down", getAmount1Delta(price, price121, liquidity, false), "99999999999999999"},
+		{"amount1 of equal prices", getAmount1Delta(price, price, liquidity, true), "0"},
+	}
+
+	for _, tt := range tests {
+		if tt.got.String() != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package v3
+
+import "math/big"
+
+// feeDenominator is the unit of pool fees, which are in hundredths of a basis point.
+var feeDenominator = big.NewInt(1000000)
+
+// swapStep is the outcome of one step of a swap within a single liquidity range.
+type swapStep struct {
+	sqrtRatioNext *big.Int
+	amountIn      *big.Int
+	amountOut     *big.Int
+	feeAmount     *big.Int
+}
+
+// computeSwapStep is SwapMath.computeSwapStep for an exact input: it swaps amountRemaining,
+// fee included, from sqrtRatioCurrent towards sqrtRatioTarget without leaving the range.
+func computeSwapStep(sqrtRatioCurrent, sqrtRatioTarget, liquidity, amountRemaining *big.Int, feePips uint32) (*swapStep, error) {
+	zeroForOne := sqrtRatioCurrent.Cmp(sqrtRatioTarget) >= 0
+	fee := big.NewInt(int64(feePips))
+	feeComplement := new(big.Int).Sub(feeDenominator, fee)
+
+	amountRemainingLessFee := mulDiv(amountRemaining, feeComplement, feeDenominator)
+
+	var amountIn *big.Int
+	if zeroForOne {
+		amountIn = getAmount0Delta(sqrtRatioTarget, sqrtRatioCurrent, liquidity, true)
+	} else {
+		amountIn = getAmount1Delta(sqrtRatioCurrent, sqrtRatioTarget, liquidity, true)
+	}
+
+	step := &swapStep{sqrtRatioNext: sqrtRatioTarget}
+	if amountRemainingLessFee.Cmp(amountIn) < 0 {
+		next, err := getNextSqrtPriceFromInput(sqrtRatioCurrent, liquidity, amountRemainingLessFee, zeroForOne)
+		if err != nil {
+			return nil, err
+		}
+		step.sqrtRatioNext = next
+	}
+
+	reached := step.sqrtRatioNext.Cmp(sqrtRatioTarget) == 0
+	if zeroForOne {
+		if !reached {
+			amountIn = getAmount0Delta(step.sqrtRatioNext, sqrtRatioCurrent, liquidity, true)
+		}
+		step.amountOut = getAmount1Delta(step.sqrtRatioNext, sqrtRatioCurrent, liquidity, false)
+	} else {
+		if !reached {
+			amountIn = getAmount1Delta(sqrtRatioCurrent, step.sqrtRatioNext, liquidity, true)
+		}
+		step.amountOut = getAmount0Delta(sqrtRatioCurrent, step.sqrtRatioNext, liquidity, false)
+	}
+	step.amountIn = amountIn
+
+	if reached {
+		step.feeAmount = mulDivRoundingUp(amountIn, fee, feeComplement)
+	} else {
+		// The target was not reached, so the whole remainder is spent and what is not swapped is fee.
+		step.feeAmount = new(big.Int).Sub(amountRemaining, amountIn)
+	}
+
+	return step, nil
+}
@@ -0,0 +1,62 @@
+package v3
+
+import "testing"
+
+// Exact input vectors from Uniswap v3-core test/SwapMath.spec.ts.
+func TestComputeSwapStep(t *testing.T) {
+	tests := []struct {
+		name                                  string
+		current, target, liquidity, remaining string
+		fee                                   uint32
+		wantNext, wantIn, wantOut, wantFee    string
+	}{
+		{
+			name:    "capped at the price target, one for zero",
+			current: "79228162514264337593543950336", target: "79623317895830914510639640423",
+			liquidity: "2000000000000000000", remaining: "1000000000000000000", fee: 600,
+			wantNext: "79623317895830914510639640423", wantIn: "9975124224178055", wantOut: "9925619580021728", wantFee: "5988667735148",
+		},
+		{
+			name:    "fully spent, one for zero",
+			current: "79228162514264337593543950336", target: "250541448375047931186413801569",
+			liquidity: "2000000000000000000", remaining: "1000000000000000000", fee: 600,
+			wantNext: "118818475322642227089037862318", wantIn: "999400000000000000", wantOut: "666399946655997866", wantFee: "600000000000000",
+		},
+		{
+			name:    "target price of 1 uses partial input",
+			current: "2", target: "1", liquidity: "1", remaining: "3915081100057732413702495386755767", fee: 1,
+			wantNext: "1", wantIn: "39614081257132168796771975168", wantOut: "0", wantFee: "39614120871253040049813",
+		},
+		{
+			name:    "entire input taken as fee",
+			current: "2413", target: "79887613182836312", liquidity: "1985041575832132834610021537970", remaining: "10", fee: 1872,
+			wantNext: "2413", wantIn: "0", wantOut: "0", wantFee: "10",
+		},
+	}
+
+	for _, tt := range tests {
+		step, err := computeSwapStep(bigInt(t, tt.current), bigInt(t, tt.target), bigInt(t, tt.liquidity), bigInt(t, tt.remaining), tt.fee)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		if step.sqrtRatioNext.String() != tt.wantNext {
+			t.Errorf("%s: sqrt price %s, want %s", tt.name, step.sqrtRatioNext, tt.wantNext)
+		}
+		if step.amountIn.String() != tt.wantIn {
+			t.Errorf("%s: amount in %s, want %s", tt.name, step.amountIn, tt.wantIn)
+		}
+		if step.amountOut.String() != tt.wantOut {
+			t.Errorf("%s: amount out %s, want %s", tt.name, step.amountOut, tt.wantOut)
+		}
+		if step.feeAmount.String() != tt.wantFee {
+			t.Errorf("%s: fee %s, want %s", tt.name, step.feeAmount, tt.wantFee)
+		}
+
+		spent := bigInt(t, tt.wantIn)
+		spent.Add(spent, step.feeAmount)
+		if spent.Cmp(bigInt(t, tt.remaining)) > 0 {
+			t.Errorf("%s: spent %s of %s", tt.name, spent, tt.remaining)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package v3
+
+import (
+	"errors"
+	"math/big"
+)
+
+// The tick range and the matching sqrt price range of a V3 pool, as in TickMath.
+const (
+	MinTick = -887272
+	MaxTick = -MinTick
+)
+
+var (
+	MinSqrtRatio = big.NewInt(4295128739)
+	MaxSqrtRatio = bigFromHex("fffd8963efd1fc6a506488495d951d5263988d26")
+
+	errTickRange      = errors.New("tick out of range")
+	errSqrtRatioRange = errors.New("sqrt price out of range")
+
+	q32         = new(big.Int).Lsh(big.NewInt(1), 32)
+	q96         = new(big.Int).Lsh(big.NewInt(1), 96)
+	q128        = new(big.Int).Lsh(big.NewInt(1), 128)
+	maxUint160  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+	maxUint256  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	tickFactors = [...]*big.Int{
+		bigFromHex("fffcb933bd6fad37aa2d162d1a594001"),
+		bigFromHex("fff97272373d413259a46990580e213a"),
+		bigFromHex("fff2e50f5f656932ef12357cf3c7fdcc"),
+		bigFromHex("ffe5caca7e10e4e61c3624eaa0941cd0"),
+		bigFromHex("ffcb9843d60f6159c9db58835c926644"),
+		bigFromHex("ff973b41fa98c081472e6896dfb254c0"),
+		bigFromHex("ff2ea16466c96a3843ec78b326b52861"),
+		bigFromHex("fe5dee046a99a2a811c461f1969c3053"),
+		bigFromHex("fcbe86c7900a88aedcffc83b479aa3a4"),
+		bigFromHex("f987a7253ac413176f2b074cf7815e54"),
+		bigFromHex("f3392b0822b70005940c7a398e4b70f3"),
+		bigFromHex("e7159475a2c29b7443b29c7fa6e889d9"),
+		bigFromHex("d097f3bdfd2022b8845ad8f792aa5825"),
+		bigFromHex("a9f746462d870fdf8a65dc1f90e061e5"),
+		bigFromHex("70d869a156d2a1b890bb3df62baf32f7"),
+		bigFromHex("31be135f97d08fd981231505542fcfa6"),
+		bigFromHex("9aa508b5b7a84e1c677de54f3e99bc9"),
+		bigFromHex("5d6af8dedb81196699c329225ee604"),
+		bigFromHex("2216e584f5fa1ea926041bedfe98"),
+		bigFromHex("48a170391f7dc42444e8fa2"),
+	}
+)
+
+// GetSqrtRatioAtTick returns sqrt(1.0001^tick) as a Q64.96, rounded like TickMath.getSqrtRatioAtTick.
+func GetSqrtRatioAtTick(tick int32) (*big.Int, error) {
+	absTick := int64(tick)
+	if absTick < 0 {
+		absTick = -absTick
+	}
+	if absTick > MaxTick {
+		return nil, errTickRange
+	}
+
+	ratio := new(big.Int).Set(q128)
+	for i, factor := range tickFactors {
+		if absTick&(1<<i) == 0 {
+			continue
+		}
+		if i == 0 {
+			ratio.Set(factor)
+			continue
+		}
+		ratio.Mul(ratio, factor).Rsh(ratio, 128)
+	}
+
+	if tick > 0 {
+		ratio.Div(maxUint256, ratio)
+	}
+
+	// Q128.128 to Q64.96, rounding up so that getTickAtSqrtRatio of the result is tick.
+	sqrtPriceX96, remainder := new(big.Int).QuoRem(ratio, q32, new(big.Int))
+	if remainder.Sign() != 0 {
+		sqrtPriceX96.Add(sqrtPriceX96, big.NewInt(1))
+	}
+
+	return sqrtPriceX96, nil
+}
+
+// GetTickAtSqrtRatio returns the greatest tick whose sqrt price is at most sqrtPriceX96, as
+// TickMath.getTickAtSqrtRatio does.
+func GetTickAtSqrtRatio(sqrtPriceX96 *big.Int) (int32, error) {
+	if sqrtPriceX96.Cmp(MinSqrtRatio) < 0 || sqrtPriceX96.Cmp(MaxSqrtRatio) >= 0 {
+		return 0, errSqrtRatioRange
+	}
+
+	// The sqrt price is monotonic in the tick, so a search over the tick range finds the same tick
+	// as the contract's log2 approximation, which is corrected against getSqrtRatioAtTick as well.
+	low, high := int32(MinTick), int32(MaxTick)
+	for low < high {
+		mid := low + (high-low+1)/2
+		ratio, err := GetSqrtRatioAtTick(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ratio.Cmp(sqrtPriceX96) <= 0 {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+
+	return low, nil
+}
+
+func bigFromHex(hex string) *big.Int {
+	n, ok := new(big.Int).SetString(hex, 16)
+	if !ok {
+		panic("invalid hex constant " + hex)
+	}
+
+	return n
+}
@@ -0,0 +1,91 @@
+package v3
+
+import (
+	"math/big"
+	"testing"
+)
+
+func bigInt(t *testing.T, s string) *big.Int {
+	t.Helper()
+
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("invalid integer %q", s)
+	}
+
+	return n
+}
+
+func TestGetSqrtRatioAtTick(t *testing.T) {
+	tests := []struct {
+		tick int32
+		want string
+	}{
+		{MinTick, "4295128739"},
+		{MinTick + 1, "4295343490"},
+		{-1, "79224201403219477170569942574"},
+		{0, "79228162514264337593543950336"},
+		{1, "79232123823359799118286999568"},
+		{MaxTick - 1, "1461373636630004318706518188784493106690254656249"},
+		{MaxTick, "1461446703485210103287273052203988822378723970342"},
+	}
+
+	for _, tt := range tests {
+		got, err := GetSqrtRatioAtTick(tt.tick)
+		if err != nil {
+			t.Fatalf("GetSqrtRatioAtTick(%d): %v", tt.tick, err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("GetSqrtRatioAtTick(%d) = %s, want %s", tt.tick, got, tt.want)
+		}
+	}
+
+	if got, _ := GetSqrtRatioAtTick(MinTick); got.Cmp(MinSqrtRatio) != 0 {
+		t.Errorf("GetSqrtRatioAtTick(MinTick) = %s, want MinSqrtRatio %s", got, MinSqrtRatio)
+	}
+	if got, _ := GetSqrtRatioAtTick(MaxTick); got.Cmp(MaxSqrtRatio) != 0 {
+		t.Errorf("GetSqrtRatioAtTick(MaxTick) = %s, want MaxSqrtRatio %s", got, MaxSqrtRatio)
+	}
+
+	for _, tick := range []int32{MinTick - 1, MaxTick + 1} {
+		if _, err := GetSqrtRatioAtTick(tick); err == nil {
+			t.Errorf("GetSqrtRatioAtTick(%d) succeeded out of range", tick)
+		}
+	}
+}
+
+func TestGetTickAtSqrtRatio(t *testing.T) {
+	one := big.NewInt(1)
+	tests := []struct {
+		sqrtPriceX96 *big.Int
+		want         int32
+	}{
+		{MinSqrtRatio, MinTick},
+		{new(big.Int).Add(MinSqrtRatio, one), MinTick},
+		{bigInt(t, "4295343490"), MinTick + 1},
+		{bigInt(t, "79224201403219477170569942573"), -2},
+		{bigInt(t, "79224201403219477170569942574"), -1},
+		{bigInt(t, "79228162514264337593543950335"), -1},
+		{bigInt(t, "79228162514264337593543950336"), 0},
+		{bigInt(t, "79232123823359799118286999567"), 0},
+		{bigInt(t, "79232123823359799118286999568"), 1},
+		{bigInt(t, "1461373636630004318706518188784493106690254656249"), MaxTick - 1},
+		{new(big.Int).Sub(MaxSqrtRatio, one), MaxTick - 1},
+	}
+
+	for _, tt := range tests {
+		got, err := GetTickAtSqrtRatio(tt.sqrtPriceX96)
+		if err != nil {
+			t.Fatalf("GetTickAtSqrtRatio(%s): %v", tt.sqrtPriceX96, err)
+		}
+		if got != tt.want {
+			t.Errorf("GetTickAtSqrtRatio(%s) = %d, want %d", tt.sqrtPriceX96, got, tt.want)
+		}
+	}
+
+	for _, sqrtPriceX96 := range []*big.Int{new(big.Int).Sub(MinSqrtRatio, one), MaxSqrtRatio} {
+		if _, err := GetTickAtSqrtRatio(sqrtPriceX96); err == nil {
+			t.Errorf("GetTickAtSqrtRatio(%s) succeeded out of range", sqrtPriceX96)
+		}
+	}
+}
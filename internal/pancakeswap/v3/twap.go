@@ -0,0 +1,112 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+	"grpc_cake/internal/blockchain/abi/gen/poolV3"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// Twap reads the pool's oracle with observe over the last window seconds, as OracleLibrary.consult
+// does. A zero fee averages every configured tier and keeps the pool with the highest harmonic mean
+// liquidity, the most expensive one to manipulate. observe fails for a window older than the
+// pool's oldest observation.
+func (v *V3) Twap(ctx context.Context, tokenA, tokenB common.Address, fee uint32, window uint64) (*pancakeswap.Twap, error) {
+	if window == 0 || window > uint64(^uint32(0)) {
+		return nil, fmt.Errorf("invalid TWAP window of %d seconds", window)
+	}
+
+	to := v.client.Head()
+	if to.Number == 0 {
+		return nil, errors.New("chain head is not known yet")
+	}
+	callOpts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(to.Number)}
+
+	feeTiers := v.feeTiers
+	if fee != 0 {
+		feeTiers = []*big.Int{new(big.Int).SetUint64(uint64(fee))}
+	}
+
+	var (
+		best    *pancakeswap.Twap
+		lastErr = fmt.Errorf("no V3 pool of %s/%s", tokenA.Hex(), tokenB.Hex())
+	)
+	for _, feeTier := range feeTiers {
+		twap, err := v.observe(callOpts, tokenA, tokenB, feeTier, uint32(window))
+		if err != nil {
+			logrus.Debugf("failed to observe the %s/%s/%s pool on %s: %v", tokenA.Hex(), tokenB.Hex(), feeTier, v.client.Chain, err)
+			lastErr = err
+			continue
+		}
+		if twap != nil && (best == nil || twap.HarmonicMeanLiquidity.Cmp(best.HarmonicMeanLiquidity) > 0) {
+			best = twap
+		}
+	}
+	if best == nil {
+		return nil, lastErr
+	}
+
+	best.To = to
+	return best, nil
+}
+
+// observe averages the pool of fee over window, nil when the pool does not exist.
+func (v *V3) observe(callOpts *bind.CallOpts, tokenA, tokenB common.Address, fee *big.Int, window uint32) (*pancakeswap.Twap, error) {
+	poolAddress, err := v.poolAddress(callOpts, tokenA, tokenB, fee)
+	if err != nil || poolAddress == (common.Address{}) {
+		return nil, err
+	}
+
+	pool, err := poolV3.NewBlockchainCaller(poolAddress, v.client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	observations, err := pool.Observe(callOpts, []uint32{window, 0})
+	if err != nil {
+		return nil, err
+	}
+	if len(observations.TickCumulatives) != 2 || len(observations.SecondsPerLiquidityCumulativeX128s) != 2 {
+		return nil, errors.New("observe returned an unexpected number of observations")
+	}
+
+	seconds := big.NewInt(int64(window))
+
+	// The mean tick is rounded towards negative infinity.
+	tickCumulativesDelta := new(big.Int).Sub(observations.TickCumulatives[1], observations.TickCumulatives[0])
+	meanTick, remainder := new(big.Int).QuoRem(tickCumulativesDelta, seconds, new(big.Int))
+	if tickCumulativesDelta.Sign() < 0 && remainder.Sign() != 0 {
+		meanTick.Sub(meanTick, big.NewInt(1))
+	}
+	tick := int32(meanTick.Int64())
+
+	// Seconds per liquidity wrap around at 2^160 like the uint160 they are stored in.
+	secondsPerLiquidityDelta := new(big.Int).Sub(observations.SecondsPerLiquidityCumulativeX128s[1], observations.SecondsPerLiquidityCumulativeX128s[0])
+	secondsPerLiquidityDelta.And(secondsPerLiquidityDelta, maxUint160)
+	if secondsPerLiquidityDelta.Sign() == 0 {
+		return nil, errors.New("observe returned no seconds per liquidity")
+	}
+	harmonicMeanLiquidity := new(big.Int).Mul(seconds, maxUint160)
+	harmonicMeanLiquidity.Div(harmonicMeanLiquidity, secondsPerLiquidityDelta.Lsh(secondsPerLiquidityDelta, 32))
+
+	sqrtPriceX96, err := GetSqrtRatioAtTick(tick)
+	if err != nil {
+		return nil, err
+	}
+
+	key := newPoolKey(tokenA, tokenB, fee)
+	return &pancakeswap.Twap{
+		Pool:                  pancakeswap.Pool{Dex: v.dex.Name, Address: poolAddress, Fee: key.fee, Token0: key.token0, Token1: key.token1},
+		Window:                uint64(window),
+		Amount0:               new(big.Int).Lsh(big.NewInt(1), 192),
+		Amount1:               new(big.Int).Mul(sqrtPriceX96, sqrtPriceX96),
+		Tick:                  tick,
+		HarmonicMeanLiquidity: harmonicMeanLiquidity,
+	}, nil
+}
@@ -1,7 +1,6 @@
 package v3
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -10,94 +9,297 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"grpc_cake/gen/go/quoteswap"
 	"grpc_cake/internal/blockchain"
 	erc20 "grpc_cake/internal/blockchain/abi/gen/erc20"
 	"grpc_cake/internal/blockchain/abi/gen/quoterV2"
 	"grpc_cake/internal/blockchain/abi/gen/routerV3"
-)
-
-var (
-	routerAddress   = common.HexToAddress("0x1b81D678ffb9C0263b24A97847620C99d213eB14")
-	quoterV2Address = common.HexToAddress("0xB048Bbc1Ee6b733FFfCFb9e9CeF7375518e25997")
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/registry"
 )
 
 type V3 struct {
+	verification    pancakeswap.Verification
+	dex             registry.Dex
+	feeTiers        []*big.Int
 	router          *routerV3.Blockchain
 	routerAddress   common.Address
 	quoterV2        *quoterV2.QuoterV2Caller
+	quoterV2ABI     *abi.ABI
 	quoterV2Address common.Address
 	client          *blockchain.Client
+	// simulator quotes head blocks off-chain, the quoter checks it and quotes everything else.
+	simulator *simulator
 }
 
-func NewV3(client *blockchain.Client) (*V3, error) {
+func NewV3(client *blockchain.Client, dex registry.Dex) (*V3, error) {
+	routerAddress, quoterV2Address := dex.Router, dex.Quoter
+
+	// Every fee tier is quoted, the pool with the largest output is the one swapped through.
+	feeTiers := make([]*big.Int, 0, len(dex.FeeTiers))
+	for _, fee := range dex.FeeTiers {
+		feeTiers = append(feeTiers, new(big.Int).SetUint64(uint64(fee)))
+	}
+
 	router, err := routerV3.NewBlockchain(routerAddress, client.Eth())
 	if err != nil {
 		return nil, err
 	}
 
-	quoterV2, err := quoterV2.NewQuoterV2Caller(quoterV2Address, client.Eth())
+	quoter, err := quoterV2.NewQuoterV2Caller(quoterV2Address, client.Eth())
+	if err != nil {
+		return nil, err
+	}
+
+	quoterABI, err := quoterV2.QuoterV2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	simulator, err := newSimulator(client, dex.Factory)
 	if err != nil {
 		return nil, err
 	}
 
 	return &V3{
+		simulator:       simulator,
+		dex:             dex,
+		feeTiers:        feeTiers,
 		router:          router,
 		routerAddress:   routerAddress,
-		quoterV2:        quoterV2,
+		quoterV2:        quoter,
+		quoterV2ABI:     quoterABI,
 		quoterV2Address: quoterV2Address,
 		client:          client,
 	}, nil
 }
 
+// Verify checks the V3 wiring against the registry once it succeeds, later calls return immediately.
+func (v *V3) Verify(ctx context.Context) error {
+	return v.verification.Ensure(ctx, v.verify)
+}
+
+// verify checks that the client serves the configured chain and that the router and quoter
+// are deployed and wired to the configured factory and wrapped native token.
+func (v *V3) verify(ctx context.Context) error {
+	if err := v.client.VerifyChainID(ctx); err != nil {
+		return err
+	}
+
+	if err := v.client.VerifyCode(ctx, "V3 router", v.routerAddress); err != nil {
+		return err
+	}
+	if err := v.client.VerifyCode(ctx, "V3 quoter", v.quoterV2Address); err != nil {
+		return err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	routerFactory, err := v.router.Factory(callOpts)
+	if err != nil {
+		return err
+	}
+	if err := v.client.ExpectAddress("V3 router factory()", routerFactory, v.dex.Factory); err != nil {
+		return err
+	}
+
+	routerWETH, err := v.router.WETH9(callOpts)
+	if err != nil {
+		return err
+	}
+	if err := v.client.ExpectAddress("V3 router WETH9()", routerWETH, v.client.Config.WrappedNative); err != nil {
+		return err
+	}
+
+	quoterFactory, err := v.quoterV2.Factory(callOpts)
+	if err != nil {
+		return err
+	}
+	if err := v.client.ExpectAddress("V3 quoter factory()", quoterFactory, v.dex.Factory); err != nil {
+		return err
+	}
+
+	quoterWETH, err := v.quoterV2.WETH9(callOpts)
+	if err != nil {
+		return err
+	}
+
+	return v.client.ExpectAddress("V3 quoter WETH9()", quoterWETH, v.client.Config.WrappedNative)
+}
+
 func (v *V3) GetQuote(ctx context.Context, req *quoteswap.GetQuoteRequest) (resp *quoteswap.GetQuoteResponse, err error) {
+	if err := v.Verify(ctx); err != nil {
+		return nil, err
+	}
+
 	tokenIn := common.HexToAddress(req.TokenIn)
 	tokenOut := common.HexToAddress(req.TokenOut)
-	amountIn := new(big.Int).SetUint64(req.Amount)
-	callOpts := &bind.CallOpts{Context: ctx}
+	amountIn, err := pancakeswap.AmountIn(req)
+	if err != nil {
+		return nil, err
+	}
+	callOpts := pancakeswap.CallOpts(ctx, req)
+
+	// quoteErr is the last quoter error, reported when no pool quotes.
+	quoteErr := errors.New("no pool quoted successfully")
+
+	// Every pool is quoted and the one with the largest output wins, tier order does not matter.
+	var (
+		best    *swapResult
+		bestFee *big.Int
+	)
+	for _, fee := range v.feeTiers {
+		path := encodePath(tokenIn, fee, tokenOut)
+
+		logrus.Infof("Request parameters: TokenIn: %s, TokenOut: %s, Amount: %s, Slippage: %d, Chain: %s", tokenIn, tokenOut, amountIn, req.SlippageBps, req.Chain)
 
-	// To check all possible pools.
-	fees := []*big.Int{big.NewInt(500), big.NewInt(1000), big.NewInt(3000), big.NewInt(10000)}
-	for _, fee := range fees {
-		path := bytes.Join([][]byte{
-			tokenIn.Bytes(),
-			EncodeUint256(fee, 3, true),
-			tokenOut.Bytes(),
-		}, nil)
+		local, check, ok := v.localQuote(ctx, req, tokenIn, tokenOut, fee, amountIn)
+		if ok && !check {
+			if local != nil && (best == nil || local.amountOut.Cmp(best.amountOut) > 0) {
+				best, bestFee = local, fee
+			}
+			continue
+		}
 
-		logrus.Infof("Request parameters: TokenIn: %s, TokenOut: %s, Amount: %d, Slippage: %d, Chain: %s", tokenIn, tokenOut, req.Amount, req.SlippageBps, req.Chain)
+		quote, err := v.quoterV2.QuoteExactInput(callOpts, path, amountIn)
 
-		outAmount, err := v.quoterV2.QuoteExactInput(callOpts, path, amountIn)
+		var quoted *swapResult
 		if err == nil {
-			resp = &quoteswap.GetQuoteResponse{
-				InputToken:  req.TokenIn,
-				InAmount:    amountIn.String(),
-				OutputToken: req.TokenOut,
-				OutAmount:   outAmount.String(),
-				SlippageBps: int32(req.SlippageBps),
-				Dex:         req.Dex,
-				Chain:       v.client.Chain,
+			quoted = newSwapResult(quote.AmountOut, quote.SqrtPriceX96AfterList, quote.InitializedTicksCrossedList)
+		}
+		if ok {
+			v.simulator.checked(tokenIn, tokenOut, fee, local, quoted)
+		}
+
+		if err != nil {
+			quoteErr = err
+			continue
+		}
+		if best == nil || quoted.amountOut.Cmp(best.amountOut) > 0 {
+			best, bestFee = quoted, fee
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("failed to get quote: %w", quoteErr)
+	}
+
+	return v.quoteResponse(req, amountIn, bestFee, best), nil
+}
+
+// localQuote simulates req through the pool of fee. It only serves requests pinned to a block
+// number, which normalized requests always are, hashes are left to the quoter.
+func (v *V3) localQuote(ctx context.Context, req *quoteswap.GetQuoteRequest, tokenIn, tokenOut common.Address, fee, amountIn *big.Int) (result *swapResult, check, ok bool) {
+	if req.GetBlockHash() != "" || req.GetBlockNumber() == 0 {
+		return nil, false, false
+	}
+
+	return v.simulator.quote(ctx, tokenIn, tokenOut, fee, amountIn, req.GetBlockNumber())
+}
+
+// QuoteCalls returns one quoteExactInput call per fee tier for req, used to batch quotes
+// through Multicall3. As in GetQuote, the fee tier quoting the largest output wins.
+func (v *V3) QuoteCalls(req *quoteswap.GetQuoteRequest) (*pancakeswap.QuoteCalls, error) {
+	tokenIn := common.HexToAddress(req.TokenIn)
+	tokenOut := common.HexToAddress(req.TokenOut)
+	amountIn, err := pancakeswap.AmountIn(req)
+	if err != nil {
+		return nil, err
+	}
+
+	calls := make([]blockchain.Call, 0, len(v.feeTiers))
+	for _, fee := range v.feeTiers {
+		callData, err := v.quoterV2ABI.Pack("quoteExactInput", encodePath(tokenIn, fee, tokenOut), amountIn)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, blockchain.Call{Target: v.quoterV2Address, CallData: callData})
+	}
+
+	decode := func(results []blockchain.CallResult) (*quoteswap.GetQuoteResponse, error) {
+		var (
+			best    *swapResult
+			bestFee *big.Int
+		)
+		for i, result := range results {
+			if !result.Success {
+				continue
+			}
+
+			out, err := v.quoterV2ABI.Unpack("quoteExactInput", result.ReturnData)
+			if err != nil {
+				continue
+			}
+
+			quoted := newSwapResult(
+				abi.ConvertType(out[0], new(big.Int)).(*big.Int),
+				*abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int),
+				*abi.ConvertType(out[2], new([]uint32)).(*[]uint32),
+			)
+			if best == nil || quoted.amountOut.Cmp(best.amountOut) > 0 {
+				best, bestFee = quoted, v.feeTiers[i]
 			}
-			return resp, nil
 		}
+
+		if best == nil {
+			return nil, errors.New("failed to get quote: no pool quoted successfully")
+		}
+
+		return v.quoteResponse(req, amountIn, bestFee, best), nil
+	}
+
+	return &pancakeswap.QuoteCalls{Calls: calls, Decode: decode}, nil
+}
+
+func (v *V3) quoteResponse(req *quoteswap.GetQuoteRequest, amountIn, fee *big.Int, swap *swapResult) *quoteswap.GetQuoteResponse {
+	resp := &quoteswap.GetQuoteResponse{
+		InputToken:              req.TokenIn,
+		InAmount:                amountIn.String(),
+		OutputToken:             req.TokenOut,
+		OutAmount:               swap.amountOut.String(),
+		SlippageBps:             int32(req.SlippageBps),
+		Dex:                     req.Dex,
+		Chain:                   v.client.Chain,
+		InitializedTicksCrossed: swap.initializedTicksCrossed,
+		Pool:                    &quoteswap.Pool{Dex: v.dex.Name, Fee: uint32(fee.Uint64())},
+	}
+	if swap.sqrtPriceX96After != nil {
+		resp.SqrtPriceX96After = swap.sqrtPriceX96After.String()
 	}
 
-	return nil, fmt.Errorf("failed to get quote: %v", err)
+	return resp
 }
 
 func (v *V3) ExecuteSwap(ctx context.Context, req *quoteswap.ExecuteTxRequest) (resp *quoteswap.ExecuteTxResponse, err error) {
+	if err := v.Verify(ctx); err != nil {
+		return nil, err
+	}
+
 	quote := req.QuotingResponse
 
-	amountIn := new(big.Int)
-	amountIn.SetString(quote.InAmount, 10)
+	amountIn, err := pancakeswap.ParseAmount(quote.InAmount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount in value: %w", err)
+	}
 
-	amountOut := new(big.Int)
-	amountOut.SetString(quote.OutAmount, 10)
+	amountOut, err := pancakeswap.ParseAmount(quote.OutAmount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount out value: %w", err)
+	}
+
+	// The swap goes through the pool that was quoted, and checked by the guardrails, only.
+	if quote.GetPool().GetFee() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "the quote has no pool to swap through")
+	}
+	fee := new(big.Int).SetUint64(uint64(quote.GetPool().GetFee()))
 
 	tokenIn := common.HexToAddress(quote.InputToken)
 	tokenOut := common.HexToAddress(quote.OutputToken)
@@ -119,62 +321,54 @@ func (v *V3) ExecuteSwap(ctx context.Context, req *quoteswap.ExecuteTxRequest) (
 
 	deadline := big.NewInt(time.Now().Add(10 * time.Minute).Unix())
 
-	logrus.Infof("Preparing swap with parameters:\n TokenIn: %s;\n TokenOut: %s;\n AmountIn: %s;\n AmountOutMin: %s;\n Recipient: %s;\n Deadline: %s;\n",
-		tokenIn.Hex(), tokenOut.Hex(), amountIn.String(), amountOut.String(), recipient.Hex(), deadline.String())
+	logrus.Infof("Preparing swap with parameters:\n TokenIn: %s;\n TokenOut: %s;\n AmountIn: %s;\n AmountOutMin: %s;\n Fee: %s;\n Recipient: %s;\n Deadline: %s;\n",
+		tokenIn.Hex(), tokenOut.Hex(), amountIn.String(), amountOut.String(), fee.String(), recipient.Hex(), deadline.String())
+
+	params := routerV3.ISwapRouterExactInputSingleParams{
+		TokenIn:           tokenIn,
+		TokenOut:          tokenOut,
+		Fee:               fee,
+		Recipient:         recipient,
+		Deadline:          deadline,
+		AmountIn:          amountIn,
+		AmountOutMinimum:  amountOut,
+		SqrtPriceLimitX96: new(big.Int),
+	}
 
-	var tx *types.Transaction
-	var opts *bind.TransactOpts
-
-	// To check all possible pools.
-	fees := []*big.Int{big.NewInt(500), big.NewInt(1000), big.NewInt(3000), big.NewInt(10000)}
-	for _, fee := range fees {
-		params := routerV3.ISwapRouterExactInputSingleParams{
-			TokenIn:           tokenIn,
-			TokenOut:          tokenOut,
-			Fee:               fee,
-			Recipient:         recipient,
-			Deadline:          deadline,
-			AmountIn:          amountIn,
-			AmountOutMinimum:  amountOut,
-			SqrtPriceLimitX96: new(big.Int).SetBytes(make([]byte, 32)),
+	opts, err := v.opts(ctx)
+	if err != nil {
+		resp = &quoteswap.ExecuteTxResponse{
+			Status: quoteswap.TransactionStatus_FAILED,
+			Error: &quoteswap.Error{
+				Code:    5,
+				Message: fmt.Sprintf("getting opts failed: %s", err.Error()),
+			},
 		}
 
-		opts, err = v.opts(ctx)
-		if err != nil {
-			resp = &quoteswap.ExecuteTxResponse{
-				Status: quoteswap.TransactionStatus_FAILED,
-				Error: &quoteswap.Error{
-					Code:    5,
-					Message: fmt.Sprintf("getting opts failed: %s", err.Error()),
-				},
-			}
+		return resp, err
+	}
 
-			return resp, err
+	tx, err := v.router.ExactInputSingle(opts, params)
+	if err != nil {
+		resp = &quoteswap.ExecuteTxResponse{
+			Status: quoteswap.TransactionStatus_FAILED,
+			Error: &quoteswap.Error{
+				Code:    5,
+				Message: fmt.Sprintf("swap failed: %s", err.Error()),
+			},
 		}
 
-		tx, err = v.router.ExactInputSingle(opts, params)
-		if err == nil {
-			resp = &quoteswap.ExecuteTxResponse{
-				TransactionHash: tx.Hash().Hex(),
-				Status:          quoteswap.TransactionStatus_PENDING,
-				SellTokenQty:    float64(amountIn.Int64()),
-				ExecutedPrice:   float64(amountOut.Int64()),
-			}
-
-			return resp, nil
-		}
-		logrus.Warnf("Swap with fee %s failed: %v", fee.String(), err)
+		return resp, err
 	}
 
 	resp = &quoteswap.ExecuteTxResponse{
-		Status: quoteswap.TransactionStatus_FAILED,
-		Error: &quoteswap.Error{
-			Code:    5,
-			Message: fmt.Sprintf("swap failed: %s", err.Error()),
-		},
+		TransactionHash: tx.Hash().Hex(),
+		Status:          quoteswap.TransactionStatus_PENDING,
+		SellTokenQty:    pancakeswap.Float64(amountIn),
+		SellTokenAmount: amountIn.String(),
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 func (v *V3) approveToken(ctx context.Context, tokenAddress, ownerAddress, spenderAddress common.Address, amount *big.Int) error {
@@ -188,7 +382,7 @@ func (v *V3) approveToken(ctx context.Context, tokenAddress, ownerAddress, spend
 		return err
 	}
 
-	logrus.Infof("Allowance for %s to spend: %s", routerAddress.Hex(), allowance.String())
+	logrus.Infof("Allowance for %s to spend: %s", v.routerAddress.Hex(), allowance.String())
 
 	opts, err := v.opts(ctx)
 	if err != nil {
@@ -219,10 +413,11 @@ func (v *V3) approveToken(ctx context.Context, tokenAddress, ownerAddress, spend
 }
 
 func (v *V3) opts(ctx context.Context) (*bind.TransactOpts, error) {
-	chainID, err := v.client.Eth().ChainID(ctx)
-	if err != nil {
+	// Refuse to sign for a node that serves another chain than the one configured.
+	if err := v.client.VerifyChainID(ctx); err != nil {
 		return nil, err
 	}
+	chainID := new(big.Int).SetUint64(v.client.Config.ChainID)
 
 	ptivateKey := os.Getenv("PRIVATE_KEY")
 	privateKeyECDSA, err := crypto.HexToECDSA(strings.TrimPrefix(ptivateKey, "0x"))
@@ -0,0 +1,85 @@
+package v3
+
+import (
+	"math/big"
+	"testing"
+
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/blockchain/abi/gen/quoterV2"
+	"grpc_cake/internal/registry"
+)
+
+func TestQuoteCallsDecode(t *testing.T) {
+	quoterABI, err := quoterV2.QuoterV2MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &V3{
+		dex:         registry.Dex{Name: registry.DexV3},
+		feeTiers:    []*big.Int{big.NewInt(100), big.NewInt(500), big.NewInt(2500)},
+		quoterV2ABI: quoterABI,
+		client:      &blockchain.Client{Chain: "bsc"},
+	}
+
+	quoted := func(amountOut int64, sqrtPriceX96After string, ticksCrossed uint32) blockchain.CallResult {
+		data, err := quoterABI.Methods["quoteExactInput"].Outputs.Pack(
+			big.NewInt(amountOut), []*big.Int{bigInt(t, sqrtPriceX96After)}, []uint32{ticksCrossed}, big.NewInt(90000))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blockchain.CallResult{Success: true, ReturnData: data}
+	}
+	reverted := blockchain.CallResult{Success: false}
+
+	tests := []struct {
+		name    string
+		results []blockchain.CallResult
+		wantOut string
+		wantFee uint32
+		// wantTicks is the initializedTicksCrossed of the chosen tier, to check its result is reported.
+		wantTicks uint32
+		wantErr   bool
+	}{
+		{"largest output wins over the first tier", []blockchain.CallResult{
+			quoted(900, "79228162514264337593543950336", 3), quoted(990, "79228162514264337593543950337", 1), quoted(980, "79228162514264337593543950338", 0),
+		}, "990", 500, 1, false},
+		{"reverted tiers are skipped", []blockchain.CallResult{
+			reverted, reverted, quoted(970, "79228162514264337593543950336", 2),
+		}, "970", 2500, 2, false},
+		{"malformed tiers are skipped", []blockchain.CallResult{
+			{Success: true, ReturnData: []byte{1, 2, 3}}, quoted(960, "79228162514264337593543950336", 0), reverted,
+		}, "960", 500, 0, false},
+		{"no tier quotes", []blockchain.CallResult{reverted, reverted, reverted}, "", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		calls, err := v.QuoteCalls(&quoteswap.GetQuoteRequest{
+			TokenIn:  "0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c",
+			TokenOut: "0x55d398326f99059fF775485246999027B3197955",
+			AmountIn: "1000",
+			Dex:      registry.DexV3,
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if len(calls.Calls) != len(v.feeTiers) {
+			t.Fatalf("%s: got %d calls, want one per fee tier", tt.name, len(calls.Calls))
+		}
+
+		resp, err := calls.Decode(tt.results)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", tt.name, resp.GetOutAmount())
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if resp.GetOutAmount() != tt.wantOut || resp.GetPool().GetFee() != tt.wantFee || resp.GetInitializedTicksCrossed() != tt.wantTicks {
+			t.Errorf("%s: got out %s fee %d ticks %d, want out %s fee %d ticks %d", tt.name,
+				resp.GetOutAmount(), resp.GetPool().GetFee(), resp.GetInitializedTicksCrossed(), tt.wantOut, tt.wantFee, tt.wantTicks)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultPath is where the registry is read from when REGISTRY_PATH is not set.
+const DefaultPath = "config/registry.json"
+
+const (
+	DexV2 = "v2"
+	DexV3 = "v3"
+	// DexSmart is the SmartRouter, which splits orders across the chain's V2 and V3 pools.
+	DexSmart = "smart"
+)
+
+// Registry describes every chain the service can connect to and the DEX deployments on it,
+// so adding a chain or a deployment is a configuration change.
+type Registry struct {
+	Chains []Chain `json:"chains"`
+}
+
+type Chain struct {
+	// Name is the chain key used in requests, e.g. "bsc".
+	Name    string `json:"name"`
+	ChainID uint64 `json:"chain_id"`
+	// RPC lists the endpoints of the chain. Entries are expanded with environment variables,
+	// and an entry expanding to a comma-separated list yields several endpoints.
+	RPC            []string       `json:"rpc"`
+	NativeSymbol   string         `json:"native_symbol"`
+	NativeDecimals uint8          `json:"native_decimals"`
+	WrappedNative  common.Address `json:"wrapped_native"`
+	ExplorerURL    string         `json:"explorer_url"`
+	// Multicall3 overrides the canonical Multicall3 address on chains where it differs.
+	Multicall3 common.Address `json:"multicall3,omitempty"`
+	// RateLimit caps the upstream RPC requests per second over all endpoints of the chain, and
+	// RateBurst is how many may be sent at once. Zero means unlimited.
+	RateLimit float64 `json:"rate_limit,omitempty"`
+	RateBurst int     `json:"rate_burst,omitempty"`
+	// Guardrails are checked before any swap on the chain is signed.
+	Guardrails Guardrails `json:"guardrails"`
+	Dexes      []Dex      `json:"dexes"`
+}
+
+// Guardrails limit the swaps of a chain. Tokens tightens the limits of swaps selling or buying a
+// token, the strictest limit of the chain and both tokens applies.
+type Guardrails struct {
+	Limits
+	// TwapWindowSeconds is the window of the TWAP max_twap_deviation_bps compares with, 1800 when zero.
+	TwapWindowSeconds uint32 `json:"twap_window_seconds,omitempty"`
+	// NotionalToken is the token max_notional is expressed in, e.g. a stablecoin.
+	NotionalToken common.Address            `json:"notional_token,omitempty"`
+	Tokens        map[common.Address]Limits `json:"tokens,omitempty"`
+}
+
+// Limits are the guardrails of a swap, zero disables a limit.
+type Limits struct {
+	// MaxPriceImpactBps caps how much worse the execution price may be than the pool's mid price, fee included.
+	MaxPriceImpactBps float64 `json:"max_price_impact_bps,omitempty"`
+	// MaxDeviationBps caps how much worse the execution price may be than the best quote of the
+	// chain's other venues for the same amount. It is not checked for a pair that no other venue
+	// quotes, since there is no reference price.
+	MaxDeviationBps float64 `json:"max_deviation_bps,omitempty"`
+	// MaxTwapDeviationBps caps how much worse the execution price may be than the TWAP of the
+	// quoted pool, fee and price impact included.
+	MaxTwapDeviationBps float64 `json:"max_twap_deviation_bps,omitempty"`
+	// MaxNotional caps the value of the sold amount in the chain's notional token, in whole tokens.
+	MaxNotional float64 `json:"max_notional,omitempty"`
+}
+
+type Dex struct {
+	// Name is the dex key used in requests, "v2", "v3" or "smart".
+	Name    string         `json:"name"`
+	Router  common.Address `json:"router"`
+	Factory common.Address `json:"factory"`
+	// Quoter is the QuoterV2 of a V3 deployment.
+	Quoter common.Address `json:"quoter,omitempty"`
+	// FeeBps is the swap fee of a V2 deployment.
+	FeeBps uint32 `json:"fee_bps,omitempty"`
+	// FeeTiers are the pool fees of a V3 deployment in hundredths of a bip. Every tier is quoted
+	// and the one with the largest output wins, so their order does not matter.
+	FeeTiers []uint32 `json:"fee_tiers,omitempty"`
+}
+
+// Load reads the registry from the file at REGISTRY_PATH, or DefaultPath.
+func Load() (*Registry, error) {
+	path := os.Getenv("REGISTRY_PATH")
+	if path == "" {
+		path = DefaultPath
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry: %w", err)
+	}
+
+	return Parse(data)
+}
+
+// Parse decodes and validates a registry, expanding environment variables in RPC endpoints.
+func Parse(data []byte) (*Registry, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var registry Registry
+	if err := decoder.Decode(&registry); err != nil {
+		return nil, fmt.Errorf("failed to parse registry: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for i := range registry.Chains {
+		chain := &registry.Chains[i]
+
+		if chain.Name == "" {
+			return nil, errors.New("registry: chain without name")
+		}
+		if seen[chain.Name] {
+			return nil, fmt.Errorf("registry: duplicate chain %s", chain.Name)
+		}
+		seen[chain.Name] = true
+
+		if chain.ChainID == 0 {
+			return nil, fmt.Errorf("registry: chain %s has no chain_id", chain.Name)
+		}
+		if chain.NativeDecimals == 0 {
+			chain.NativeDecimals = 18
+		}
+		if chain.RateLimit < 0 || chain.RateBurst < 0 {
+			return nil, fmt.Errorf("registry: chain %s has a negative rate limit", chain.Name)
+		}
+		if chain.RateLimit > 0 && chain.RateBurst == 0 {
+			chain.RateBurst = max(1, int(chain.RateLimit))
+		}
+
+		if err := chain.Guardrails.validate(); err != nil {
+			return nil, fmt.Errorf("registry: chain %s: %w", chain.Name, err)
+		}
+
+		var endpoints []string
+		for _, rpc := range chain.RPC {
+			for _, endpoint := range strings.Split(os.ExpandEnv(rpc), ",") {
+				if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+					endpoints = append(endpoints, endpoint)
+				}
+			}
+		}
+		chain.RPC = endpoints
+
+		dexes := make(map[string]bool)
+		for _, dex := range chain.Dexes {
+			if dex.Name != DexV2 && dex.Name != DexV3 && dex.Name != DexSmart {
+				return nil, fmt.Errorf("registry: chain %s has unsupported dex %q", chain.Name, dex.Name)
+			}
+			if dexes[dex.Name] {
+				return nil, fmt.Errorf("registry: chain %s has duplicate dex %s", chain.Name, dex.Name)
+			}
+			dexes[dex.Name] = true
+
+			if dex.Router == (common.Address{}) {
+				return nil, fmt.Errorf("registry: %s %s has no router", chain.Name, dex.Name)
+			}
+			if dex.Name == DexV3 && (dex.Quoter == (common.Address{}) || len(dex.FeeTiers) == 0) {
+				return nil, fmt.Errorf("registry: %s %s needs a quoter and fee_tiers", chain.Name, dex.Name)
+			}
+		}
+		if dexes[DexSmart] && !dexes[DexV2] && !dexes[DexV3] {
+			return nil, fmt.Errorf("registry: chain %s has a smart router but no v2 or v3 deployment", chain.Name)
+		}
+	}
+
+	return &registry, nil
+}
+
+// For returns the limits of a swap of tokenIn for tokenOut.
+func (g Guardrails) For(tokenIn, tokenOut common.Address) Limits {
+	limits := g.Limits
+	for _, address := range []common.Address{tokenIn, tokenOut} {
+		token, ok := g.Tokens[address]
+		if !ok {
+			continue
+		}
+		limits.MaxPriceImpactBps = tighter(limits.MaxPriceImpactBps, token.MaxPriceImpactBps)
+		limits.MaxDeviationBps = tighter(limits.MaxDeviationBps, token.MaxDeviationBps)
+		limits.MaxTwapDeviationBps = tighter(limits.MaxTwapDeviationBps, token.MaxTwapDeviationBps)
+		limits.MaxNotional = tighter(limits.MaxNotional, token.MaxNotional)
+	}
+
+	return limits
+}
+
+func (g Guardrails) validate() error {
+	limits := []Limits{g.Limits}
+	for _, token := range g.Tokens {
+		limits = append(limits, token)
+	}
+
+	for _, l := range limits {
+		if l.MaxPriceImpactBps < 0 || l.MaxDeviationBps < 0 || l.MaxTwapDeviationBps < 0 || l.MaxNotional < 0 {
+			return errors.New("guardrails must not be negative")
+		}
+		if l.MaxNotional > 0 && g.NotionalToken == (common.Address{}) {
+			return errors.New("guardrails max_notional needs a notional_token")
+		}
+	}
+
+	return nil
+}
+
+// tighter returns the stricter of two limits, zero being no limit.
+func tighter(a, b float64) float64 {
+	if a == 0 || b != 0 && b < a {
+		return b
+	}
+
+	return a
+}
+
+// Chain returns the chain named name.
+func (r *Registry) Chain(name string) (Chain, bool) {
+	for _, chain := range r.Chains {
+		if chain.Name == name {
+			return chain, true
+		}
+	}
+
+	return Chain{}, false
+}
+
+// Dex returns the deployment named name on the chain.
+func (c Chain) Dex(name string) (Dex, bool) {
+	for _, dex := range c.Dexes {
+		if dex.Name == name {
+			return dex, true
+		}
+	}
+
+	return Dex{}, false
+}
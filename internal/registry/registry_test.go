@@ -0,0 +1,110 @@
+package registry
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestParse(t *testing.T) {
+	const router = `"router": "0x10ED43C718714eb63d5aA57B78B54704E256024E"`
+	chain := func(fields string) string {
+		return `{"chains": [{"name": "bsc", "chain_id": 56` + fields + `}]}`
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"malformed", `{"chains": [`, "failed to parse registry"},
+		{"unknown field", `{"chains": [], "networks": []}`, "unknown field"},
+		{"chain without name", `{"chains": [{"chain_id": 56}]}`, "chain without name"},
+		{"duplicate chain", `{"chains": [{"name": "bsc", "chain_id": 56}, {"name": "bsc", "chain_id": 56}]}`, "duplicate chain bsc"},
+		{"no chain id", `{"chains": [{"name": "bsc"}]}`, "has no chain_id"},
+		{"negative rate limit", chain(`, "rate_limit": -1`), "negative rate limit"},
+		{"negative guardrail", chain(`, "guardrails": {"max_price_impact_bps": -1}`), "must not be negative"},
+		{"negative token guardrail", chain(`, "guardrails": {"tokens": {"0x55d398326f99059fF775485246999027B3197955": {"max_deviation_bps": -5}}}`), "must not be negative"},
+		{"notional without token", chain(`, "guardrails": {"max_notional": 1000}`), "needs a notional_token"},
+		{"unsupported dex", chain(`, "dexes": [{"name": "v4", ` + router + `}]`), `unsupported dex "v4"`},
+		{"duplicate dex", chain(`, "dexes": [{"name": "v2", ` + router + `}, {"name": "v2", ` + router + `}]`), "duplicate dex v2"},
+		{"dex without router", chain(`, "dexes": [{"name": "v2"}]`), "has no router"},
+		{"v3 without quoter", chain(`, "dexes": [{"name": "v3", ` + router + `, "fee_tiers": [500]}]`), "needs a quoter and fee_tiers"},
+		{"v3 without fee tiers", chain(`, "dexes": [{"name": "v3", ` + router + `, "quoter": "0xB048Bbc1Ee6b733FFfCFb9e9CeF7375518e25997"}]`), "needs a quoter and fee_tiers"},
+		{"smart router alone", chain(`, "dexes": [{"name": "smart", ` + router + `}]`), "smart router but no v2 or v3"},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse([]byte(tt.data))
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseDefaults(t *testing.T) {
+	t.Setenv("TEST_BSC_RPC", "https://a.example, https://b.example")
+
+	registry, err := Parse([]byte(`{"chains": [{"name": "bsc", "chain_id": 56, "rpc": ["$TEST_BSC_RPC", " ", "https://c.example"], "rate_limit": 2.5}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chain, ok := registry.Chain("bsc")
+	if !ok {
+		t.Fatal("chain bsc not found")
+	}
+	if want := []string{"https://a.example", "https://b.example", "https://c.example"}; strings.Join(chain.RPC, " ") != strings.Join(want, " ") {
+		t.Errorf("rpc: got %v, want %v", chain.RPC, want)
+	}
+	if chain.NativeDecimals != 18 {
+		t.Errorf("native decimals: got %d, want 18", chain.NativeDecimals)
+	}
+	if chain.RateBurst != 2 {
+		t.Errorf("rate burst: got %d, want 2", chain.RateBurst)
+	}
+}
+
+func TestParseShippedRegistry(t *testing.T) {
+	data, err := os.ReadFile("../../" + DefaultPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse(data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGuardrailsFor(t *testing.T) {
+	tokenA := common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+	tokenB := common.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+	other := common.HexToAddress("0x0E09FaBB73Bd3Ade0a17ECC321fD13a19e81cE82")
+
+	guardrails := Guardrails{
+		Limits: Limits{MaxPriceImpactBps: 50, MaxDeviationBps: 30, MaxNotional: 10000},
+		Tokens: map[common.Address]Limits{
+			tokenA: {MaxPriceImpactBps: 20, MaxDeviationBps: 60, MaxTwapDeviationBps: 100},
+			tokenB: {MaxPriceImpactBps: 40, MaxTwapDeviationBps: 80, MaxNotional: 500},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		tokenIn, tokenOut common.Address
+		want              Limits
+	}{
+		{"chain limits only", other, other, Limits{MaxPriceImpactBps: 50, MaxDeviationBps: 30, MaxNotional: 10000}},
+		{"token tightens, never loosens", tokenA, other, Limits{MaxPriceImpactBps: 20, MaxDeviationBps: 30, MaxTwapDeviationBps: 100, MaxNotional: 10000}},
+		{"bought token counts too", other, tokenB, Limits{MaxPriceImpactBps: 40, MaxDeviationBps: 30, MaxTwapDeviationBps: 80, MaxNotional: 500}},
+		{"strictest of both tokens", tokenA, tokenB, Limits{MaxPriceImpactBps: 20, MaxDeviationBps: 30, MaxTwapDeviationBps: 80, MaxNotional: 500}},
+		{"either direction", tokenB, tokenA, Limits{MaxPriceImpactBps: 20, MaxDeviationBps: 30, MaxTwapDeviationBps: 80, MaxNotional: 500}},
+	}
+
+	for _, tt := range tests {
+		if got := guardrails.For(tt.tokenIn, tt.tokenOut); got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
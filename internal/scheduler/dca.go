@@ -0,0 +1,524 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"grpc_cake/gen/go/quoteswap"
+)
+
+// DexAuto buys on the venue of the chain quoting the most output at each run.
+const DexAuto = "auto"
+
+var (
+	ErrPlanNotFound = errors.New("DCA plan not found")
+	ErrPlanDone     = errors.New("DCA plan is no longer active")
+	// ErrNoRun is returned for a plan whose schedule has no run before it ends.
+	ErrNoRun = errors.New("the schedule has no run before the plan ends")
+)
+
+// Plan buys TokenOut for AmountIn of TokenIn at every run of its cron Schedule. Amounts are base
+// units.
+type Plan struct {
+	ID       string   `json:"id"`
+	Status   Status   `json:"status"`
+	Chain    string   `json:"chain"`
+	Dex      string   `json:"dex"`
+	TokenIn  string   `json:"token_in"`
+	TokenOut string   `json:"token_out"`
+	AmountIn *big.Int `json:"amount_in"`
+
+	Schedule    string `json:"schedule"`
+	SlippageBps uint32 `json:"slippage_bps"`
+	// MaxPrice is the highest price paid in whole TokenIn per whole TokenOut, none when empty.
+	MaxPrice  string    `json:"max_price,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// EndsAt is zero for a plan that runs until cancelled.
+	EndsAt time.Time `json:"ends_at"`
+	NextAt time.Time `json:"next_at"`
+
+	// Failures counts consecutive failed runs, skipped runs leave it unchanged.
+	Failures int      `json:"failures,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	Spent    *big.Int `json:"spent"`
+	Bought   *big.Int `json:"bought"`
+	Runs     []Slice  `json:"runs,omitempty"`
+}
+
+// Done reports whether the plan no longer runs.
+func (p *Plan) Done() bool {
+	return p.Status != StatusActive
+}
+
+// checkPrice fails when a run at executionPrice, in whole TokenOut per whole TokenIn, pays more than
+// MaxPrice for TokenOut. The price paid is the inverse of the execution price.
+func (p *Plan) checkPrice(executionPrice string) error {
+	if p.MaxPrice == "" {
+		return nil
+	}
+
+	price, ok := new(big.Rat).SetString(executionPrice)
+	if !ok || price.Sign() <= 0 {
+		return errors.New("the quote has no execution price")
+	}
+	maxPrice, ok := new(big.Rat).SetString(p.MaxPrice)
+	if !ok {
+		return fmt.Errorf("invalid max price %q", p.MaxPrice)
+	}
+
+	if paid := new(big.Rat).Inv(price); paid.Cmp(maxPrice) > 0 {
+		return fmt.Errorf("price of %s exceeds the max price %s", paid.FloatString(8), p.MaxPrice)
+	}
+
+	return nil
+}
+
+// fail counts a failed run and fails the plan after maxFailures in a row.
+func (p *Plan) fail(reason string) {
+	p.Failures++
+	if p.Failures >= maxFailures && !p.Done() {
+		p.Status = StatusFailed
+		p.Error = fmt.Sprintf("%d runs failed in a row, last: %s", p.Failures, reason)
+	}
+}
+
+func (p *Plan) clone() *Plan {
+	c := *p
+	c.AmountIn = copyInt(p.AmountIn)
+	c.Spent = copyInt(p.Spent)
+	c.Bought = copyInt(p.Bought)
+
+	c.Runs = make([]Slice, len(p.Runs))
+	for i, run := range p.Runs {
+		run.AmountIn = copyInt(run.AmountIn)
+		run.QuotedOut = copyInt(run.QuotedOut)
+		run.FilledIn = copyInt(run.FilledIn)
+		run.FilledOut = copyInt(run.FilledOut)
+		run.GasPaid = copyInt(run.GasPaid)
+		c.Runs[i] = run
+	}
+
+	return &c
+}
+
+// Planner runs DCA plans, each in its own loop like the Scheduler's orders. A run missed while the
+// service was stopped is made once at startup, the following runs keep to the schedule.
+type Planner struct {
+	executor Executor
+	store    store[*Plan]
+
+	mu    sync.Mutex
+	plans map[string]*Plan
+	// cancelled is closed when a plan is cancelled, to stop waiting for its next run.
+	cancelled map[string]chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPlanner loads the plans persisted at path. Plans do not run until Start is called.
+func NewPlanner(path string, executor Executor) (*Planner, error) {
+	p := &Planner{
+		executor:  executor,
+		store:     store[*Plan]{path: path},
+		plans:     make(map[string]*Plan),
+		cancelled: make(map[string]chan struct{}),
+	}
+
+	plans, err := p.store.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range plans {
+		p.plans[plan.ID] = plan
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+
+	return p, nil
+}
+
+// Start runs every active plan and settles the pending swaps of finished ones.
+func (p *Planner) Start() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for id, plan := range p.plans {
+		if !plan.Done() || hasPendingRun(plan) {
+			logrus.Infof("Resuming DCA plan %s", id)
+			p.startLocked(id)
+		}
+	}
+}
+
+// Stop stops running plans and waits for their loops to return. Swaps in flight stay pending and
+// are settled after a restart.
+func (p *Planner) Stop() {
+	p.cancel()
+	p.wg.Wait()
+}
+
+// Create starts a plan, its first run is the schedule's next match.
+func (p *Planner) Create(plan Plan) (*Plan, error) {
+	schedule, err := ParseSchedule(plan.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	plan.ID = id
+	plan.Status = StatusActive
+	plan.CreatedAt = now
+	plan.NextAt = schedule.Next(now)
+	plan.Spent = new(big.Int)
+	plan.Bought = new(big.Int)
+	plan.Runs = nil
+	if plan.NextAt.IsZero() || (!plan.EndsAt.IsZero() && plan.NextAt.After(plan.EndsAt)) {
+		return nil, fmt.Errorf("%w: %q", ErrNoRun, plan.Schedule)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.plans[id] = &plan
+	if err := p.saveLocked(); err != nil {
+		delete(p.plans, id)
+		return nil, err
+	}
+	p.startLocked(id)
+
+	logrus.Infof("Created DCA plan %s: %s of %s for %s on %s %s at %q, first run at %s", id, plan.AmountIn, plan.TokenIn, plan.TokenOut, plan.Chain, plan.Dex, plan.Schedule, plan.NextAt.Format(time.RFC3339))
+
+	return plan.clone(), nil
+}
+
+// Cancel stops an active plan. A run already sent is still settled.
+func (p *Planner) Cancel(id string) (*Plan, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	plan, ok := p.plans[id]
+	if !ok {
+		return nil, ErrPlanNotFound
+	}
+	if plan.Done() {
+		return plan.clone(), ErrPlanDone
+	}
+
+	plan.Status = StatusCancelled
+	if cancelled, ok := p.cancelled[id]; ok {
+		close(cancelled)
+		delete(p.cancelled, id)
+	}
+	if err := p.saveLocked(); err != nil {
+		logrus.Errorf("failed to persist DCA plans: %v", err)
+	}
+
+	logrus.Infof("Cancelled DCA plan %s", id)
+
+	return plan.clone(), nil
+}
+
+// Get returns a copy of the plan.
+func (p *Planner) Get(id string) (*Plan, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	plan, ok := p.plans[id]
+	if !ok {
+		return nil, ErrPlanNotFound
+	}
+
+	return plan.clone(), nil
+}
+
+// List returns copies of the plans of chain, or of all chains when it is empty, oldest first.
+// Finished plans are only included with includeDone.
+func (p *Planner) List(chain string, includeDone bool) []*Plan {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var plans []*Plan
+	for _, plan := range p.plans {
+		if (chain == "" || plan.Chain == chain) && (includeDone || !plan.Done()) {
+			plans = append(plans, plan.clone())
+		}
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].CreatedAt.Before(plans[j].CreatedAt)
+	})
+
+	return plans
+}
+
+// startLocked starts the loop of a plan. The lock must be held.
+func (p *Planner) startLocked(id string) {
+	cancelled := make(chan struct{})
+	p.cancelled[id] = cancelled
+
+	p.wg.Add(1)
+	go p.run(id, cancelled)
+}
+
+// run works a plan until it is done: it settles sent swaps, waits for the next run and makes it.
+func (p *Planner) run(id string, cancelled <-chan struct{}) {
+	defer p.wg.Done()
+
+	for {
+		if !p.settle(id) {
+			return
+		}
+
+		plan, err := p.Get(id)
+		if err != nil || plan.Done() {
+			return
+		}
+
+		timer := time.NewTimer(time.Until(plan.NextAt))
+		select {
+		case <-p.ctx.Done():
+			timer.Stop()
+			return
+		case <-cancelled:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		p.buy(id)
+	}
+}
+
+// settle waits for the pending swaps of a plan to be mined and records their fills, a swap not
+// mined within pendingTimeout fails its run. It returns false when the planner is stopping.
+func (p *Planner) settle(id string) bool {
+	plan, err := p.Get(id)
+	if err != nil {
+		return false
+	}
+
+	for _, run := range plan.Runs {
+		if run.Status != SlicePending {
+			continue
+		}
+
+		resp, err := awaitSwap(p.ctx, p.executor, plan.Chain, plan.TokenIn, plan.TokenOut, run)
+		if p.ctx.Err() != nil {
+			return false
+		}
+		if err != nil {
+			logrus.Warnf("failed to settle run %d of DCA plan %s: %v", run.Index, id, err)
+			p.update(id, func(plan *Plan) {
+				plan.Runs[run.Index].Error = err.Error()
+			})
+			return !stopping(p.ctx, time.Minute)
+		}
+
+		p.update(id, func(plan *Plan) {
+			settled := &plan.Runs[run.Index]
+			if !settled.settle(resp) {
+				plan.fail(settled.Error)
+				return
+			}
+
+			plan.Spent.Add(plan.Spent, settled.FilledIn)
+			plan.Bought.Add(plan.Bought, settled.FilledOut)
+			plan.Failures = 0
+		})
+	}
+
+	return true
+}
+
+// buy makes the current run of a plan: it quotes the plan's venue, or every venue of the chain for
+// DexAuto, and swaps through ExecuteSwap unless the price exceeds the plan's maximum. A run the
+// guardrails reject is skipped, not failed. A swapping run is persisted as pending before it is sent.
+func (p *Planner) buy(id string) {
+	plan, err := p.Get(id)
+	if err != nil || plan.Done() {
+		return
+	}
+
+	now := time.Now()
+	if !plan.EndsAt.IsZero() && now.After(plan.EndsAt) {
+		p.finish(id, StatusExpired)
+		return
+	}
+
+	run := Slice{Index: len(plan.Runs), ExecutedAt: now, AmountIn: plan.AmountIn, Dex: plan.Dex}
+
+	quote, err := p.quote(plan)
+	if err == nil {
+		run.Dex = quote.GetDex()
+		run.QuotedOut, _ = new(big.Int).SetString(quote.GetOutAmount(), 10)
+		run.PriceImpactBps = quote.GetPriceImpactBps()
+
+		if priceErr := plan.checkPrice(quote.GetExecutionPrice()); priceErr != nil {
+			run.Status, run.Error = SliceSkipped, priceErr.Error()
+		}
+	}
+
+	switch {
+	case err != nil:
+		run.Status, run.Error = SliceFailed, err.Error()
+	case run.Status == SliceSkipped:
+	default:
+		var sendErr error
+		run, sendErr = sendSwap(p.ctx, p.executor, quote, plan.SlippageBps, run.Index, func(run Slice) {
+			run.Dex = quote.GetDex()
+			p.update(id, func(plan *Plan) {
+				plan.Runs = putExecution(plan.Runs, run)
+			})
+		})
+		run.Dex = quote.GetDex()
+		if rejected(sendErr) {
+			run.Status = SliceSkipped
+		}
+	}
+
+	switch run.Status {
+	case SliceSkipped:
+		logrus.Warnf("Skipping run %d of DCA plan %s: %s", run.Index, id, run.Error)
+	case SliceFailed:
+		logrus.Warnf("Run %d of DCA plan %s failed: %s", run.Index, id, run.Error)
+	}
+
+	schedule, err := ParseSchedule(plan.Schedule)
+	if err != nil {
+		// The schedule was parsed when the plan was created.
+		logrus.Errorf("invalid schedule of DCA plan %s: %v", id, err)
+		return
+	}
+
+	p.update(id, func(plan *Plan) {
+		plan.Runs = putExecution(plan.Runs, run)
+		if run.Status == SliceFailed {
+			plan.fail(run.Error)
+		}
+
+		plan.NextAt = schedule.Next(now)
+		if !plan.Done() && (plan.NextAt.IsZero() || (!plan.EndsAt.IsZero() && plan.NextAt.After(plan.EndsAt))) {
+			plan.Status = StatusExpired
+		}
+	})
+}
+
+// quote quotes the plan's amount on its venue, or on every ready venue of its chain for DexAuto,
+// keeping the quote with the most output.
+func (p *Planner) quote(plan *Plan) (*quoteswap.GetQuoteResponse, error) {
+	req := &quoteswap.GetQuoteRequest{
+		TokenIn:     plan.TokenIn,
+		TokenOut:    plan.TokenOut,
+		AmountIn:    plan.AmountIn.String(),
+		Dex:         plan.Dex,
+		Chain:       plan.Chain,
+		SlippageBps: plan.SlippageBps,
+	}
+	if plan.Dex != DexAuto {
+		return p.executor.GetQuote(p.ctx, req)
+	}
+
+	venues, err := p.executor.ListVenues(p.ctx, &quoteswap.ListVenuesRequest{Chain: plan.Chain})
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &quoteswap.BatchGetQuoteRequest{}
+	for _, venue := range venues.GetVenues() {
+		if !venue.GetReady() {
+			continue
+		}
+		venueReq := proto.Clone(req).(*quoteswap.GetQuoteRequest)
+		venueReq.Dex = venue.GetDex()
+		batch.Quotes = append(batch.Quotes, venueReq)
+	}
+	if len(batch.Quotes) == 0 {
+		return nil, fmt.Errorf("no venue of %s is ready", plan.Chain)
+	}
+
+	resp, err := p.executor.BatchGetQuote(p.ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *quoteswap.GetQuoteResponse
+	var bestOut *big.Int
+	var errs []error
+	for i, result := range resp.GetResults() {
+		if result.GetError() != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", batch.Quotes[i].GetDex(), result.GetError().GetMessage()))
+			continue
+		}
+		out, ok := new(big.Int).SetString(result.GetQuote().GetOutAmount(), 10)
+		if ok && (bestOut == nil || out.Cmp(bestOut) > 0) {
+			best, bestOut = result.GetQuote(), out
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("no venue quoted the run: %w", errors.Join(errs...))
+	}
+
+	return best, nil
+}
+
+// finish ends an active plan with status.
+func (p *Planner) finish(id string, status Status) {
+	p.update(id, func(plan *Plan) {
+		if plan.Done() {
+			return
+		}
+		plan.Status = status
+		logrus.Infof("DCA plan %s %s: spent %s for %s", id, status, plan.Spent, plan.Bought)
+	})
+}
+
+// update applies fn to a plan and persists all plans.
+func (p *Planner) update(id string, fn func(plan *Plan)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	plan, ok := p.plans[id]
+	if !ok {
+		return
+	}
+	fn(plan)
+
+	if err := p.saveLocked(); err != nil {
+		logrus.Errorf("failed to persist DCA plans: %v", err)
+	}
+}
+
+// saveLocked persists all plans, oldest first. The lock must be held.
+func (p *Planner) saveLocked() error {
+	plans := make([]*Plan, 0, len(p.plans))
+	for _, plan := range p.plans {
+		plans = append(plans, plan)
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].CreatedAt.Before(plans[j].CreatedAt)
+	})
+
+	return p.store.save(plans)
+}
+
+func hasPendingRun(plan *Plan) bool {
+	for _, run := range plan.Runs {
+		if run.Status == SlicePending {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckPrice(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxPrice       string
+		executionPrice string
+		wantErr        string
+	}{
+		{"no max price", "", "", ""},
+		{"paid below the max price", "0.004", "300", ""},
+		{"paid at the max price", "0.004", "250", ""},
+		{"paid above the max price", "0.004", "200", "exceeds the max price"},
+		{"fractional execution price", "400", "0.0025", ""},
+		{"fractional execution price paying more", "400", "0.002", "exceeds the max price"},
+		{"no execution price", "0.004", "", "no execution price"},
+		{"zero execution price", "0.004", "0", "no execution price"},
+		{"negative execution price", "0.004", "-300", "no execution price"},
+		{"invalid max price", "cheap", "300", "invalid max price"},
+	}
+
+	for _, tt := range tests {
+		plan := &Plan{MaxPrice: tt.maxPrice}
+		err := plan.checkPrice(tt.executionPrice)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"grpc_cake/gen/go/quoteswap"
+)
+
+// floorOut returns out less slippageBps.
+func floorOut(out *big.Int, slippageBps uint32) *big.Int {
+	floor := new(big.Int).Mul(out, big.NewInt(int64(10000-slippageBps)))
+
+	return floor.Div(floor, big.NewInt(10000))
+}
+
+// pendingTimeout is how long a sent swap may stay unmined before its execution is failed.
+// ExecuteSwap gives swaps a 10 minute deadline, past which they can no longer fill.
+const pendingTimeout = 15 * time.Minute
+
+// sendSwap sends the swap of quote as the index-th execution of an order and returns the error of
+// ExecuteSwap, if any, along with it. ExecuteSwap takes out amounts as minimum outputs on every dex,
+// so out_amount and the out amount of every split leg are lowered by slippageBps.
+//
+// record is called with the execution as pending and without a transaction hash before the swap
+// is sent, for the caller to persist it, so a swap sent right before a crash is still accounted
+// for. The returned execution, with its hash or error, replaces it.
+func sendSwap(ctx context.Context, executor Executor, quote *quoteswap.GetQuoteResponse, slippageBps uint32, index int, record func(execution Slice)) (Slice, error) {
+	out, _ := new(big.Int).SetString(quote.GetOutAmount(), 10)
+	in, _ := new(big.Int).SetString(quote.GetInAmount(), 10)
+
+	swap := proto.Clone(quote).(*quoteswap.GetQuoteResponse)
+	swap.OutAmount = floorOut(out, slippageBps).String()
+	for _, leg := range swap.GetSplits() {
+		legOut, _ := new(big.Int).SetString(leg.GetOutAmount(), 10)
+		leg.OutAmount = floorOut(legOut, slippageBps).String()
+	}
+
+	execution := Slice{
+		Index:          index,
+		ExecutedAt:     time.Now(),
+		Status:         SlicePending,
+		AmountIn:       in,
+		QuotedOut:      out,
+		PriceImpactBps: quote.GetPriceImpactBps(),
+	}
+	record(execution)
+
+	resp, err := executor.ExecuteSwap(ctx, &quoteswap.ExecuteTxRequest{QuotingResponse: swap})
+	switch {
+	case err != nil:
+		execution.Status, execution.Error = SliceFailed, err.Error()
+	case resp.GetStatus() != quoteswap.TransactionStatus_PENDING:
+		execution.Status, execution.Error = SliceFailed, resp.GetError().GetMessage()
+	default:
+		execution.TxHash = resp.GetTransactionHash()
+	}
+
+	return execution, err
+}
+
+// putExecution stores execution at its index in executions, appending it when it is new.
+func putExecution(executions []Slice, execution Slice) []Slice {
+	if execution.Index < len(executions) {
+		executions[execution.Index] = execution
+		return executions
+	}
+
+	return append(executions, execution)
+}
+
+// rejected reports whether err is ExecuteSwap refusing a swap for the chain's guardrails.
+func rejected(err error) bool {
+	st, ok := status.FromError(err)
+
+	return ok && st.Code() == codes.FailedPrecondition && strings.HasPrefix(st.Message(), "guardrails:")
+}
+
+// awaitSwap waits for the pending swap of an execution to be mined and returns the response filled
+// from its receipt. An execution still unsettled pendingTimeout after it was sent is reported
+// failed instead of an error: its swap can no longer fill. That includes one persisted without a
+// transaction hash, whose sending was interrupted by a crash.
+func awaitSwap(ctx context.Context, executor Executor, chain, tokenIn, tokenOut string, execution Slice) (*quoteswap.ExecuteTxResponse, error) {
+	quote := &quoteswap.GetQuoteResponse{
+		Chain:       chain,
+		InputToken:  tokenIn,
+		OutputToken: tokenOut,
+		OutAmount:   execution.QuotedOut.String(),
+	}
+	resp := &quoteswap.ExecuteTxResponse{TransactionHash: execution.TxHash, Status: quoteswap.TransactionStatus_PENDING}
+
+	var err error
+	if execution.TxHash == "" {
+		err = errors.New("the swap was interrupted before its transaction hash was recorded")
+	} else {
+		err = executor.AwaitFill(ctx, quote, resp)
+	}
+	if err == nil {
+		return resp, nil
+	}
+	if time.Since(execution.ExecutedAt) < pendingTimeout || ctx.Err() != nil {
+		return nil, err
+	}
+
+	return &quoteswap.ExecuteTxResponse{
+		TransactionHash: execution.TxHash,
+		Status:          quoteswap.TransactionStatus_FAILED,
+		Error:           &quoteswap.Error{Code: 5, Message: fmt.Sprintf("not settled within %s of being sent: %v", pendingTimeout, err)},
+	}, nil
+}
+
+// stopping waits for d and reports whether ctx was done first.
+func stopping(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return true
+	case <-timer.C:
+		return false
+	}
+}
@@ -0,0 +1,187 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"grpc_cake/gen/go/quoteswap"
+)
+
+// fakeExecutor quotes and executes swaps with the functions it is given and records the swaps sent.
+type fakeExecutor struct {
+	quote   func(req *quoteswap.GetQuoteRequest) (*quoteswap.GetQuoteResponse, error)
+	execute func(quote *quoteswap.GetQuoteResponse) (*quoteswap.ExecuteTxResponse, error)
+	await   func(resp *quoteswap.ExecuteTxResponse) error
+	twap    func(req *quoteswap.GetTwapRequest) (*quoteswap.GetTwapResponse, error)
+
+	swaps []*quoteswap.GetQuoteResponse
+}
+
+func (f *fakeExecutor) GetQuote(_ context.Context, req *quoteswap.GetQuoteRequest) (*quoteswap.GetQuoteResponse, error) {
+	return f.quote(req)
+}
+
+func (f *fakeExecutor) BatchGetQuote(_ context.Context, req *quoteswap.BatchGetQuoteRequest) (*quoteswap.BatchGetQuoteResponse, error) {
+	resp := &quoteswap.BatchGetQuoteResponse{}
+	for _, quoteReq := range req.GetQuotes() {
+		quote, err := f.quote(quoteReq)
+		if err != nil {
+			resp.Results = append(resp.Results, &quoteswap.BatchQuoteResult{Error: &quoteswap.Error{Message: err.Error()}})
+			continue
+		}
+		resp.Results = append(resp.Results, &quoteswap.BatchQuoteResult{Quote: quote})
+	}
+
+	return resp, nil
+}
+
+func (f *fakeExecutor) ExecuteSwap(_ context.Context, req *quoteswap.ExecuteTxRequest) (*quoteswap.ExecuteTxResponse, error) {
+	f.swaps = append(f.swaps, req.GetQuotingResponse())
+	if f.execute == nil {
+		return &quoteswap.ExecuteTxResponse{TransactionHash: "0x01", Status: quoteswap.TransactionStatus_PENDING}, nil
+	}
+
+	return f.execute(req.GetQuotingResponse())
+}
+
+func (f *fakeExecutor) GetTwap(_ context.Context, req *quoteswap.GetTwapRequest) (*quoteswap.GetTwapResponse, error) {
+	if f.twap == nil {
+		return nil, errors.New("no TWAP")
+	}
+
+	return f.twap(req)
+}
+
+func (f *fakeExecutor) ListVenues(context.Context, *quoteswap.ListVenuesRequest) (*quoteswap.ListVenuesResponse, error) {
+	return &quoteswap.ListVenuesResponse{}, nil
+}
+
+func (f *fakeExecutor) AwaitFill(_ context.Context, _ *quoteswap.GetQuoteResponse, resp *quoteswap.ExecuteTxResponse) error {
+	return f.await(resp)
+}
+
+func TestFloorOut(t *testing.T) {
+	tests := []struct {
+		out         int64
+		slippageBps uint32
+		want        int64
+	}{
+		{1000, 0, 1000},
+		{1000, 50, 995},
+		{999, 50, 994},
+		{1000, 10000, 0},
+	}
+
+	for _, tt := range tests {
+		if got := floorOut(big.NewInt(tt.out), tt.slippageBps); got.Int64() != tt.want {
+			t.Errorf("floorOut(%d, %d) = %s, want %d", tt.out, tt.slippageBps, got, tt.want)
+		}
+	}
+}
+
+func TestSendSwap(t *testing.T) {
+	quote := &quoteswap.GetQuoteResponse{
+		InAmount:       "100",
+		OutAmount:      "1000",
+		PriceImpactBps: "1.50",
+		Splits: []*quoteswap.SplitLeg{
+			{InAmount: "70", OutAmount: "700"},
+			{InAmount: "30", OutAmount: "300"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		execute    func(quote *quoteswap.GetQuoteResponse) (*quoteswap.ExecuteTxResponse, error)
+		wantStatus SliceStatus
+		wantHash   string
+		wantError  string
+	}{
+		{"pending", nil, SlicePending, "0x01", ""},
+		{"rejected", func(*quoteswap.GetQuoteResponse) (*quoteswap.ExecuteTxResponse, error) {
+			return nil, errors.New("guardrails: too much")
+		}, SliceFailed, "", "guardrails: too much"},
+		{"failed", func(*quoteswap.GetQuoteResponse) (*quoteswap.ExecuteTxResponse, error) {
+			return &quoteswap.ExecuteTxResponse{Status: quoteswap.TransactionStatus_FAILED, Error: &quoteswap.Error{Message: "approval failed"}}, nil
+		}, SliceFailed, "", "approval failed"},
+	}
+
+	for _, tt := range tests {
+		executor := &fakeExecutor{execute: tt.execute}
+		var recorded []Slice
+
+		got, _ := sendSwap(context.Background(), executor, quote, 50, 3, func(execution Slice) {
+			recorded = append(recorded, execution)
+			if len(executor.swaps) != 0 {
+				t.Errorf("%s: the execution was recorded after the swap was sent", tt.name)
+			}
+		})
+
+		if len(recorded) != 1 || recorded[0].Status != SlicePending || recorded[0].TxHash != "" || recorded[0].Index != 3 {
+			t.Errorf("%s: recorded %+v, want one pending execution without hash", tt.name, recorded)
+		}
+		if got.Status != tt.wantStatus || got.TxHash != tt.wantHash || got.Error != tt.wantError || got.Index != 3 {
+			t.Errorf("%s: got status %s hash %q error %q index %d, want %s %q %q 3", tt.name, got.Status, got.TxHash, got.Error, got.Index, tt.wantStatus, tt.wantHash, tt.wantError)
+		}
+		if got.AmountIn.Int64() != 100 || got.QuotedOut.Int64() != 1000 {
+			t.Errorf("%s: got amount in %s quoted out %s, want 100 and 1000", tt.name, got.AmountIn, got.QuotedOut)
+		}
+
+		swap := executor.swaps[0]
+		if swap.GetOutAmount() != "995" || swap.GetSplits()[0].GetOutAmount() != "696" || swap.GetSplits()[1].GetOutAmount() != "298" {
+			t.Errorf("%s: sent minimums %s, %s and %s, want 995, 696 and 298", tt.name, swap.GetOutAmount(), swap.GetSplits()[0].GetOutAmount(), swap.GetSplits()[1].GetOutAmount())
+		}
+	}
+
+	if quote.GetOutAmount() != "1000" || quote.GetSplits()[0].GetOutAmount() != "700" {
+		t.Error("sendSwap modified the quote")
+	}
+}
+
+func TestAwaitSwap(t *testing.T) {
+	mined := func(resp *quoteswap.ExecuteTxResponse) error {
+		resp.Status = quoteswap.TransactionStatus_SUCCESS
+		return nil
+	}
+	notMined := func(*quoteswap.ExecuteTxResponse) error {
+		return errors.New("timed out")
+	}
+
+	tests := []struct {
+		name       string
+		hash       string
+		sent       time.Duration
+		await      func(resp *quoteswap.ExecuteTxResponse) error
+		wantStatus quoteswap.TransactionStatus
+		wantErr    bool
+	}{
+		{"mined", "0x01", time.Minute, mined, quoteswap.TransactionStatus_SUCCESS, false},
+		{"mined late", "0x01", time.Hour, mined, quoteswap.TransactionStatus_SUCCESS, false},
+		{"not mined yet", "0x01", time.Minute, notMined, 0, true},
+		{"not mined past the timeout", "0x01", pendingTimeout + time.Minute, notMined, quoteswap.TransactionStatus_FAILED, false},
+		{"interrupted before the hash", "", time.Minute, mined, 0, true},
+		{"interrupted past the timeout", "", pendingTimeout + time.Minute, mined, quoteswap.TransactionStatus_FAILED, false},
+	}
+
+	for _, tt := range tests {
+		executor := &fakeExecutor{await: tt.await}
+		execution := Slice{TxHash: tt.hash, ExecutedAt: time.Now().Add(-tt.sent), Status: SlicePending, QuotedOut: big.NewInt(1000)}
+
+		resp, err := awaitSwap(context.Background(), executor, "bsc", "0xa", "0xb", execution)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", tt.name, resp.GetStatus())
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if resp.GetStatus() != tt.wantStatus {
+			t.Errorf("%s: got %s, want %s", tt.name, resp.GetStatus(), tt.wantStatus)
+		}
+	}
+}
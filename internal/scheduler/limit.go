@@ -0,0 +1,456 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+)
+
+var (
+	ErrLimitNotFound = errors.New("limit order not found")
+	ErrLimitDone     = errors.New("limit order is no longer active")
+)
+
+// LimitOrder sells AmountIn once the quote of the pair, less SlippageBps, reaches MinAmountOut.
+// Amounts are base units.
+type LimitOrder struct {
+	ID       string   `json:"id"`
+	Status   Status   `json:"status"`
+	Chain    string   `json:"chain"`
+	Dex      string   `json:"dex"`
+	TokenIn  string   `json:"token_in"`
+	TokenOut string   `json:"token_out"`
+	AmountIn *big.Int `json:"amount_in"`
+
+	// LimitPrice is the price as placed, in whole tokens, MinAmountOut the same price applied to
+	// AmountIn.
+	LimitPrice   string    `json:"limit_price"`
+	MinAmountOut *big.Int  `json:"min_amount_out"`
+	SlippageBps  uint32    `json:"slippage_bps"`
+	CreatedAt    time.Time `json:"created_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+
+	CheckedBlock uint64   `json:"checked_block,omitempty"`
+	LastOut      *big.Int `json:"last_out,omitempty"`
+	// Failures counts consecutive failed executions.
+	Failures   int     `json:"failures,omitempty"`
+	Error      string  `json:"error,omitempty"`
+	Executions []Slice `json:"executions,omitempty"`
+}
+
+// Done reports whether the order is no longer monitored.
+func (o *LimitOrder) Done() bool {
+	return o.Status != StatusActive
+}
+
+// pending reports whether a swap of the order is not known to be mined yet.
+func (o *LimitOrder) pending() bool {
+	for _, execution := range o.Executions {
+		if execution.Status == SlicePending {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reachable reports whether out, less the order's slippage, meets its limit.
+func (o *LimitOrder) reachable(out *big.Int) bool {
+	return floorOut(out, o.SlippageBps).Cmp(o.MinAmountOut) >= 0
+}
+
+// fail counts a failed execution and fails the order after maxFailures in a row.
+func (o *LimitOrder) fail(reason string) {
+	o.Failures++
+	if o.Failures >= maxFailures && !o.Done() {
+		o.Status = StatusFailed
+		o.Error = fmt.Sprintf("%d executions failed in a row, last: %s", o.Failures, reason)
+	}
+}
+
+func (o *LimitOrder) clone() *LimitOrder {
+	c := *o
+	c.AmountIn = copyInt(o.AmountIn)
+	c.MinAmountOut = copyInt(o.MinAmountOut)
+	c.LastOut = copyInt(o.LastOut)
+
+	c.Executions = make([]Slice, len(o.Executions))
+	for i, execution := range o.Executions {
+		execution.AmountIn = copyInt(execution.AmountIn)
+		execution.QuotedOut = copyInt(execution.QuotedOut)
+		execution.FilledIn = copyInt(execution.FilledIn)
+		execution.FilledOut = copyInt(execution.FilledOut)
+		execution.GasPaid = copyInt(execution.GasPaid)
+		c.Executions[i] = execution
+	}
+
+	return &c
+}
+
+// LimitBook monitors limit orders at every new head of their chain and executes them once their
+// limit is reachable. Like the Scheduler, it persists every change and swaps sent before a restart
+// are settled by Start.
+type LimitBook struct {
+	executor Executor
+	store    store[*LimitOrder]
+
+	mu     sync.Mutex
+	orders map[string]*LimitOrder
+	// checking holds the chains whose orders are being checked, heads arriving meanwhile are skipped.
+	checking map[string]bool
+	// executing holds the orders with a swap being sent or settled.
+	executing map[string]bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewLimitBook loads the limit orders persisted at path. Orders are not monitored until Start is
+// called.
+func NewLimitBook(path string, executor Executor) (*LimitBook, error) {
+	b := &LimitBook{
+		executor:  executor,
+		store:     store[*LimitOrder]{path: path},
+		orders:    make(map[string]*LimitOrder),
+		checking:  make(map[string]bool),
+		executing: make(map[string]bool),
+	}
+
+	orders, err := b.store.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range orders {
+		b.orders[order.ID] = order
+	}
+	b.ctx, b.cancel = context.WithCancel(context.Background())
+
+	return b, nil
+}
+
+// Start monitors the orders of every chain in clients and settles swaps sent before a restart.
+func (b *LimitBook) Start(clients map[string]*blockchain.Client) {
+	for chain, client := range clients {
+		client.OnNewHead(func(head blockchain.Block) {
+			b.onHead(chain, head)
+		})
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for id, order := range b.orders {
+		if order.pending() {
+			logrus.Infof("Settling limit order %s", id)
+			b.executing[id] = true
+			b.wg.Add(1)
+			go func() {
+				defer b.wg.Done()
+				b.settle(id)
+			}()
+		}
+	}
+}
+
+// Stop stops monitoring orders and waits for executions in flight. Swaps not yet mined stay
+// pending and are settled after a restart.
+func (b *LimitBook) Stop() {
+	// Heads are checked under the lock, so none starts a check once the context is done.
+	b.mu.Lock()
+	b.cancel()
+	b.mu.Unlock()
+
+	b.wg.Wait()
+}
+
+// Place adds an order to be monitored from the next head of its chain.
+func (b *LimitBook) Place(order LimitOrder) (*LimitOrder, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	order.ID = id
+	order.Status = StatusActive
+	order.CreatedAt = time.Now()
+	order.Executions = nil
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.orders[id] = &order
+	if err := b.saveLocked(); err != nil {
+		delete(b.orders, id)
+		return nil, err
+	}
+
+	logrus.Infof("Placed limit order %s: %s of %s for at least %s of %s on %s %s until %s", id, order.AmountIn, order.TokenIn, order.MinAmountOut, order.TokenOut, order.Chain, order.Dex, order.ExpiresAt.Format(time.RFC3339))
+
+	return order.clone(), nil
+}
+
+// Cancel stops monitoring an active order. A swap already sent is still settled.
+func (b *LimitBook) Cancel(id string) (*LimitOrder, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	order, ok := b.orders[id]
+	if !ok {
+		return nil, ErrLimitNotFound
+	}
+	if order.Done() {
+		return order.clone(), ErrLimitDone
+	}
+
+	order.Status = StatusCancelled
+	if err := b.saveLocked(); err != nil {
+		logrus.Errorf("failed to persist limit orders: %v", err)
+	}
+
+	logrus.Infof("Cancelled limit order %s", id)
+
+	return order.clone(), nil
+}
+
+// Get returns a copy of the order.
+func (b *LimitBook) Get(id string) (*LimitOrder, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	order, ok := b.orders[id]
+	if !ok {
+		return nil, ErrLimitNotFound
+	}
+
+	return order.clone(), nil
+}
+
+// onHead starts checking the orders of chain at head. It runs on the client's head goroutine, so
+// the check itself runs on its own.
+func (b *LimitBook) onHead(chain string, head blockchain.Block) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ctx.Err() != nil || b.checking[chain] {
+		return
+	}
+	b.checking[chain] = true
+
+	b.wg.Add(1)
+	go b.check(chain, head)
+}
+
+// check expires the orders of chain past their expiry at head, quotes the others in one batch and
+// executes those whose limit is reachable. The last quote of an order is only persisted with its
+// next change, not at every head.
+func (b *LimitBook) check(chain string, head blockchain.Block) {
+	defer b.wg.Done()
+	defer func() {
+		b.mu.Lock()
+		delete(b.checking, chain)
+		b.mu.Unlock()
+	}()
+
+	headTime := time.Unix(int64(head.Time), 0)
+
+	var orders []*LimitOrder
+	expired := false
+	b.mu.Lock()
+	for _, order := range b.orders {
+		if order.Chain != chain || order.Done() || b.executing[order.ID] {
+			continue
+		}
+		if !headTime.Before(order.ExpiresAt) {
+			order.Status = StatusExpired
+			expired = true
+			logrus.Infof("Limit order %s expired at block %d", order.ID, head.Number)
+			continue
+		}
+		orders = append(orders, order.clone())
+	}
+	if expired {
+		if err := b.saveLocked(); err != nil {
+			logrus.Errorf("failed to persist limit orders: %v", err)
+		}
+	}
+	b.mu.Unlock()
+
+	if len(orders) == 0 {
+		return
+	}
+
+	req := &quoteswap.BatchGetQuoteRequest{}
+	for _, order := range orders {
+		req.Quotes = append(req.Quotes, &quoteswap.GetQuoteRequest{
+			TokenIn:     order.TokenIn,
+			TokenOut:    order.TokenOut,
+			AmountIn:    order.AmountIn.String(),
+			Dex:         order.Dex,
+			Chain:       order.Chain,
+			SlippageBps: order.SlippageBps,
+			BlockNumber: head.Number,
+		})
+	}
+
+	resp, err := b.executor.BatchGetQuote(b.ctx, req)
+	if err != nil {
+		logrus.Warnf("failed to quote limit orders on %s at block %d: %v", chain, head.Number, err)
+		return
+	}
+
+	for i, result := range resp.GetResults() {
+		order := orders[i]
+		if result.GetError() != nil {
+			logrus.Debugf("failed to quote limit order %s at block %d: %s", order.ID, head.Number, result.GetError().GetMessage())
+			continue
+		}
+
+		quote := result.GetQuote()
+		out, ok := new(big.Int).SetString(quote.GetOutAmount(), 10)
+		if !ok {
+			continue
+		}
+
+		reachable := order.reachable(out)
+		b.mu.Lock()
+		current := b.orders[order.ID]
+		current.CheckedBlock, current.LastOut = head.Number, out
+		reachable = reachable && !current.Done() && !b.executing[order.ID]
+		if reachable {
+			b.executing[order.ID] = true
+			b.wg.Add(1)
+			go b.execute(order.ID, quote)
+		}
+		b.mu.Unlock()
+	}
+}
+
+// execute sends the swap of an order whose limit is reachable at quote and settles it. The swap's
+// minimum output is the quote less the order's slippage, which still meets the limit. The execution
+// is persisted as pending before the swap is sent.
+func (b *LimitBook) execute(id string, quote *quoteswap.GetQuoteResponse) {
+	defer b.wg.Done()
+
+	order, err := b.Get(id)
+	if err != nil {
+		b.release(id)
+		return
+	}
+
+	logrus.Infof("Limit order %s is reachable at block %d: quoted %s for at least %s", id, quote.GetBlockNumber(), quote.GetOutAmount(), order.MinAmountOut)
+
+	execution, _ := sendSwap(b.ctx, b.executor, quote, order.SlippageBps, len(order.Executions), func(execution Slice) {
+		b.update(id, func(order *LimitOrder) {
+			order.Executions = putExecution(order.Executions, execution)
+		})
+	})
+	b.update(id, func(order *LimitOrder) {
+		order.Executions = putExecution(order.Executions, execution)
+		if execution.Status == SliceFailed {
+			logrus.Warnf("Execution of limit order %s failed: %s", id, execution.Error)
+			order.fail(execution.Error)
+		}
+	})
+
+	if execution.Status != SlicePending {
+		b.release(id)
+		return
+	}
+
+	b.settle(id)
+}
+
+// settle waits for the pending swap of an order to be mined and records its fill. A reverted swap,
+// or one not mined within pendingTimeout, leaves the order active to be retried at a later head.
+func (b *LimitBook) settle(id string) {
+	for {
+		order, err := b.Get(id)
+		if err != nil {
+			b.release(id)
+			return
+		}
+
+		index := len(order.Executions) - 1
+		if index < 0 || order.Executions[index].Status != SlicePending {
+			b.release(id)
+			return
+		}
+
+		resp, err := awaitSwap(b.ctx, b.executor, order.Chain, order.TokenIn, order.TokenOut, order.Executions[index])
+		if b.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logrus.Warnf("failed to settle limit order %s: %v", id, err)
+			b.update(id, func(order *LimitOrder) {
+				order.Executions[index].Error = err.Error()
+			})
+			if stopping(b.ctx, time.Minute) {
+				return
+			}
+			continue
+		}
+
+		b.update(id, func(order *LimitOrder) {
+			execution := &order.Executions[index]
+			if !execution.settle(resp) {
+				logrus.Warnf("Swap of limit order %s failed: %s", id, execution.Error)
+				order.fail(execution.Error)
+				return
+			}
+
+			// A swap sent before the order was cancelled or expired still filled it.
+			order.Status = StatusCompleted
+			order.Failures = 0
+			logrus.Infof("Limit order %s filled: sold %s of %s for %s of %s", id, execution.FilledIn, order.TokenIn, execution.FilledOut, order.TokenOut)
+		})
+		b.release(id)
+		return
+	}
+}
+
+// release lets the order be checked again.
+func (b *LimitBook) release(id string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.executing, id)
+}
+
+// update applies fn to an order and persists all orders.
+func (b *LimitBook) update(id string, fn func(order *LimitOrder)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	order, ok := b.orders[id]
+	if !ok {
+		return
+	}
+	fn(order)
+
+	if err := b.saveLocked(); err != nil {
+		logrus.Errorf("failed to persist limit orders: %v", err)
+	}
+}
+
+// saveLocked persists all orders, oldest first. The lock must be held.
+func (b *LimitBook) saveLocked() error {
+	orders := make([]*LimitOrder, 0, len(b.orders))
+	for _, order := range b.orders {
+		orders = append(orders, order)
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].CreatedAt.Before(orders[j].CreatedAt)
+	})
+
+	return b.store.save(orders)
+}
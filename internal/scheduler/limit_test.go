@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+)
+
+func TestReachable(t *testing.T) {
+	tests := []struct {
+		name        string
+		out         int64
+		slippageBps uint32
+		want        bool
+	}{
+		{"at the limit", 1000, 0, true},
+		{"below the limit", 999, 0, false},
+		{"above the limit", 1001, 0, true},
+		{"at the limit less slippage", 1006, 50, true},
+		{"below the limit less slippage", 1005, 50, false},
+		{"slippage of everything", 1000000, 10000, false},
+	}
+
+	for _, tt := range tests {
+		order := &LimitOrder{MinAmountOut: big.NewInt(1000), SlippageBps: tt.slippageBps}
+		if got := order.reachable(big.NewInt(tt.out)); got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLimitBookCheck(t *testing.T) {
+	head := blockchain.Block{Number: 100, Time: uint64(time.Now().Unix())}
+	filled := func(resp *quoteswap.ExecuteTxResponse) error {
+		resp.Status = quoteswap.TransactionStatus_SUCCESS
+		resp.Fill = &quoteswap.Fill{AmountIn: "100", AmountOut: "1004"}
+		return nil
+	}
+	reverted := func(resp *quoteswap.ExecuteTxResponse) error {
+		resp.Status = quoteswap.TransactionStatus_FAILED
+		resp.Error = &quoteswap.Error{Message: "reverted"}
+		return nil
+	}
+
+	tests := []struct {
+		name      string
+		out       string
+		expiresIn time.Duration
+		await     func(resp *quoteswap.ExecuteTxResponse) error
+
+		wantStatus   Status
+		wantMinimum  string
+		wantFailures int
+	}{
+		{"not reachable", "1004", time.Hour, filled, StatusActive, "", 0},
+		{"reachable and filled", "1010", time.Hour, filled, StatusCompleted, "1004", 0},
+		{"reachable and reverted", "1010", time.Hour, reverted, StatusActive, "1004", 1},
+		{"expired", "1010", 0, filled, StatusExpired, "", 0},
+	}
+
+	for _, tt := range tests {
+		executor := &fakeExecutor{
+			quote: func(req *quoteswap.GetQuoteRequest) (*quoteswap.GetQuoteResponse, error) {
+				if req.GetBlockNumber() != head.Number {
+					t.Errorf("%s: quoted at block %d, want the head", tt.name, req.GetBlockNumber())
+				}
+				return &quoteswap.GetQuoteResponse{InAmount: req.GetAmountIn(), OutAmount: tt.out, BlockNumber: head.Number}, nil
+			},
+			await: tt.await,
+		}
+		b, err := NewLimitBook(filepath.Join(t.TempDir(), "limits.json"), executor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		order, err := b.Place(LimitOrder{
+			Chain:        "bsc",
+			AmountIn:     big.NewInt(100),
+			MinAmountOut: big.NewInt(1000),
+			SlippageBps:  50,
+			ExpiresAt:    time.Unix(int64(head.Time), 0).Add(tt.expiresIn),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b.onHead("bsc", head)
+		b.wg.Wait()
+
+		got, _ := b.Get(order.ID)
+		if got.Status != tt.wantStatus || got.Failures != tt.wantFailures {
+			t.Errorf("%s: got %s with %d failures, want %s with %d", tt.name, got.Status, got.Failures, tt.wantStatus, tt.wantFailures)
+		}
+		if tt.wantMinimum == "" {
+			if len(executor.swaps) != 0 || len(got.Executions) != 0 {
+				t.Errorf("%s: got %d swaps, want none", tt.name, len(executor.swaps))
+			}
+			continue
+		}
+		if len(executor.swaps) != 1 || executor.swaps[0].GetOutAmount() != tt.wantMinimum {
+			t.Fatalf("%s: got swaps %v, want one with minimum %s", tt.name, executor.swaps, tt.wantMinimum)
+		}
+		if len(got.Executions) != 1 || got.Executions[0].Status == SlicePending {
+			t.Errorf("%s: got executions %+v, want one settled", tt.name, got.Executions)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package scheduler
+
+import (
+	"math/big"
+	"time"
+
+	"grpc_cake/gen/go/quoteswap"
+)
+
+type Status string
+
+const (
+	StatusActive    Status = "active"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+	StatusExpired   Status = "expired"
+	StatusFailed    Status = "failed"
+)
+
+type SliceStatus string
+
+const (
+	// SlicePending is a sent swap that is not known to be mined yet.
+	SlicePending SliceStatus = "pending"
+	SliceSuccess SliceStatus = "success"
+	SliceFailed  SliceStatus = "failed"
+	// SliceSkipped is a slice that sent no swap, e.g. because its price impact was too high.
+	SliceSkipped SliceStatus = "skipped"
+)
+
+// Order is a parent order sold in slices over time. Amounts are base units.
+type Order struct {
+	ID       string   `json:"id"`
+	Status   Status   `json:"status"`
+	Chain    string   `json:"chain"`
+	Dex      string   `json:"dex"`
+	TokenIn  string   `json:"token_in"`
+	TokenOut string   `json:"token_out"`
+	AmountIn *big.Int `json:"amount_in"`
+
+	// Slices are sent every (EndsAt - CreatedAt) / Slices, the first one at CreatedAt.
+	Slices            int       `json:"slices"`
+	CreatedAt         time.Time `json:"created_at"`
+	EndsAt            time.Time `json:"ends_at"`
+	SlippageBps       uint32    `json:"slippage_bps"`
+	MaxSliceImpactBps float64   `json:"max_slice_impact_bps,omitempty"`
+
+	// SizeFactor scales the even share of the remaining amount a slice sells, adapted to the
+	// price impact slices observe.
+	SizeFactor float64   `json:"size_factor"`
+	NextAt     time.Time `json:"next_at"`
+	// Failures counts consecutive failed slices.
+	Failures int    `json:"failures,omitempty"`
+	Error    string `json:"error,omitempty"`
+
+	FilledIn  *big.Int `json:"filled_in"`
+	FilledOut *big.Int `json:"filled_out"`
+	Fills     []Slice  `json:"fills,omitempty"`
+}
+
+// Slice is one child swap of an order.
+type Slice struct {
+	Index          int         `json:"index"`
+	ExecutedAt     time.Time   `json:"executed_at"`
+	Status         SliceStatus `json:"status"`
+	AmountIn       *big.Int    `json:"amount_in"`
+	QuotedOut      *big.Int    `json:"quoted_out,omitempty"`
+	PriceImpactBps string      `json:"price_impact_bps,omitempty"`
+	TxHash         string      `json:"tx_hash,omitempty"`
+	Error          string      `json:"error,omitempty"`
+	// Dex is the venue the slice was quoted on, set when the order picks one per slice.
+	Dex string `json:"dex,omitempty"`
+
+	// The fill reported by the receipt of a mined swap.
+	FilledIn       *big.Int `json:"filled_in,omitempty"`
+	FilledOut      *big.Int `json:"filled_out,omitempty"`
+	EffectivePrice string   `json:"effective_price,omitempty"`
+	GasPaid        *big.Int `json:"gas_paid,omitempty"`
+	BlockNumber    uint64   `json:"block_number,omitempty"`
+}
+
+// settle records the mined swap of a pending slice from resp, as filled by AwaitFill, and reports
+// whether it succeeded.
+func (s *Slice) settle(resp *quoteswap.ExecuteTxResponse) bool {
+	s.Error = resp.GetError().GetMessage()
+	if resp.GetStatus() != quoteswap.TransactionStatus_SUCCESS {
+		s.Status = SliceFailed
+		return false
+	}
+
+	fill := resp.GetFill()
+	s.Status = SliceSuccess
+	s.FilledIn, _ = new(big.Int).SetString(fill.GetAmountIn(), 10)
+	s.FilledOut, _ = new(big.Int).SetString(fill.GetAmountOut(), 10)
+	s.GasPaid, _ = new(big.Int).SetString(fill.GetGasPaid(), 10)
+	s.EffectivePrice = fill.GetEffectivePrice()
+	s.BlockNumber = fill.GetBlockNumber()
+	if s.FilledIn == nil {
+		s.FilledIn = new(big.Int)
+	}
+	if s.FilledOut == nil {
+		s.FilledOut = new(big.Int)
+	}
+
+	return true
+}
+
+// Interval is the time between two slices.
+func (o *Order) Interval() time.Duration {
+	return o.EndsAt.Sub(o.CreatedAt) / time.Duration(o.Slices)
+}
+
+// Remaining is the amount left to sell. Pending slices count as sold, failed ones do not.
+func (o *Order) Remaining() *big.Int {
+	remaining := new(big.Int).Set(o.AmountIn)
+	for _, slice := range o.Fills {
+		switch slice.Status {
+		case SliceSuccess:
+			remaining.Sub(remaining, slice.FilledIn)
+		case SlicePending:
+			remaining.Sub(remaining, slice.AmountIn)
+		}
+	}
+
+	return remaining
+}
+
+// Done reports whether the order is no longer worked.
+func (o *Order) Done() bool {
+	return o.Status != StatusActive
+}
+
+// clone returns a deep copy of the order, so callers never share state with the scheduler.
+func (o *Order) clone() *Order {
+	c := *o
+	c.AmountIn = copyInt(o.AmountIn)
+	c.FilledIn = copyInt(o.FilledIn)
+	c.FilledOut = copyInt(o.FilledOut)
+
+	c.Fills = make([]Slice, len(o.Fills))
+	for i, slice := range o.Fills {
+		slice.AmountIn = copyInt(slice.AmountIn)
+		slice.QuotedOut = copyInt(slice.QuotedOut)
+		slice.FilledIn = copyInt(slice.FilledIn)
+		slice.FilledOut = copyInt(slice.FilledOut)
+		slice.GasPaid = copyInt(slice.GasPaid)
+		c.Fills[i] = slice
+	}
+
+	return &c
+}
+
+func copyInt(n *big.Int) *big.Int {
+	if n == nil {
+		return nil
+	}
+
+	return new(big.Int).Set(n)
+}
@@ -0,0 +1,443 @@
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"grpc_cake/gen/go/quoteswap"
+)
+
+const (
+	// maxFailures consecutive failed slices fail the order.
+	maxFailures = 3
+	// maxShrinks is how often a slice is halved before it is skipped for its price impact.
+	maxShrinks = 3
+	// minSizeFactor and maxSizeFactor bound how far slices are sized away from an even split.
+	minSizeFactor = 0.25
+	maxSizeFactor = 2
+)
+
+var (
+	ErrNotFound = errors.New("scheduled order not found")
+	ErrDone     = errors.New("scheduled order is no longer active")
+)
+
+// Executor quotes and sends the child swaps of orders. It is the service itself, so every slice
+// goes through the same venue checks and guardrails as a client's swap.
+type Executor interface {
+	GetQuote(ctx context.Context, req *quoteswap.GetQuoteRequest) (*quoteswap.GetQuoteResponse, error)
+	BatchGetQuote(ctx context.Context, req *quoteswap.BatchGetQuoteRequest) (*quoteswap.BatchGetQuoteResponse, error)
+	ExecuteSwap(ctx context.Context, req *quoteswap.ExecuteTxRequest) (*quoteswap.ExecuteTxResponse, error)
+	GetTwap(ctx context.Context, req *quoteswap.GetTwapRequest) (*quoteswap.GetTwapResponse, error)
+	ListVenues(ctx context.Context, req *quoteswap.ListVenuesRequest) (*quoteswap.ListVenuesResponse, error)
+	// AwaitFill waits for the swap in resp to be mined and fills resp from its receipt.
+	AwaitFill(ctx context.Context, quote *quoteswap.GetQuoteResponse, resp *quoteswap.ExecuteTxResponse) error
+}
+
+// Scheduler works orders in slices over time. Every change to an order is persisted, and orders
+// that were active when the service stopped are resumed by Start, including swaps sent but not
+// yet known to be mined.
+type Scheduler struct {
+	executor Executor
+	store    store[*Order]
+
+	mu     sync.Mutex
+	orders map[string]*Order
+	// cancelled is closed when an order is cancelled, to stop waiting for its next slice.
+	cancelled map[string]chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New loads the orders persisted at path. Orders are not worked until Start is called.
+func New(path string, executor Executor) (*Scheduler, error) {
+	s := &Scheduler{
+		executor:  executor,
+		store:     store[*Order]{path: path},
+		orders:    make(map[string]*Order),
+		cancelled: make(map[string]chan struct{}),
+	}
+
+	orders, err := s.store.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range orders {
+		s.orders[order.ID] = order
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	return s, nil
+}
+
+// Start works every active order and settles the pending swaps of finished ones.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, order := range s.orders {
+		if !order.Done() || hasPending(order) {
+			logrus.Infof("Resuming scheduled order %s", id)
+			s.startLocked(id)
+		}
+	}
+}
+
+// Stop stops working orders and waits for their loops to return. Swaps in flight stay pending and
+// are settled after a restart.
+func (s *Scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// Create schedules order to be sold in order.Slices slices over duration, starting now.
+func (s *Scheduler) Create(order Order, duration time.Duration) (*Order, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	order.ID = id
+	order.Status = StatusActive
+	order.CreatedAt = now
+	order.EndsAt = now.Add(duration)
+	order.NextAt = now
+	order.SizeFactor = 1
+	order.FilledIn = new(big.Int)
+	order.FilledOut = new(big.Int)
+	order.Fills = nil
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.orders[id] = &order
+	if err := s.saveLocked(); err != nil {
+		delete(s.orders, id)
+		return nil, err
+	}
+	s.startLocked(id)
+
+	logrus.Infof("Scheduled order %s: %s of %s for %s on %s %s in %d slices over %s", id, order.AmountIn, order.TokenIn, order.TokenOut, order.Chain, order.Dex, order.Slices, duration)
+
+	return order.clone(), nil
+}
+
+// Cancel stops an active order. A slice already sent is still settled.
+func (s *Scheduler) Cancel(id string) (*Order, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	order, ok := s.orders[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if order.Done() {
+		return order.clone(), ErrDone
+	}
+
+	order.Status = StatusCancelled
+	if cancelled, ok := s.cancelled[id]; ok {
+		close(cancelled)
+		delete(s.cancelled, id)
+	}
+	if err := s.saveLocked(); err != nil {
+		logrus.Errorf("failed to persist scheduled orders: %v", err)
+	}
+
+	logrus.Infof("Cancelled scheduled order %s", id)
+
+	return order.clone(), nil
+}
+
+// Get returns a copy of the order.
+func (s *Scheduler) Get(id string) (*Order, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	order, ok := s.orders[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return order.clone(), nil
+}
+
+// startLocked starts the loop of an order. The lock must be held.
+func (s *Scheduler) startLocked(id string) {
+	cancelled := make(chan struct{})
+	s.cancelled[id] = cancelled
+
+	s.wg.Add(1)
+	go s.run(id, cancelled)
+}
+
+// run works an order until it is done: it settles sent swaps, waits for the next slice and sends it.
+func (s *Scheduler) run(id string, cancelled <-chan struct{}) {
+	defer s.wg.Done()
+
+	for {
+		if !s.settle(id) {
+			return
+		}
+
+		order, err := s.Get(id)
+		if err != nil || order.Done() {
+			return
+		}
+
+		timer := time.NewTimer(time.Until(order.NextAt))
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return
+		case <-cancelled:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.slice(id)
+	}
+}
+
+// settle waits for the pending swaps of an order to be mined and records their fills, a swap not
+// mined within pendingTimeout fails its slice. It returns false when the scheduler is stopping.
+func (s *Scheduler) settle(id string) bool {
+	order, err := s.Get(id)
+	if err != nil {
+		return false
+	}
+
+	for _, slice := range order.Fills {
+		if slice.Status != SlicePending {
+			continue
+		}
+
+		resp, err := awaitSwap(s.ctx, s.executor, order.Chain, order.TokenIn, order.TokenOut, slice)
+		if s.ctx.Err() != nil {
+			return false
+		}
+		if err != nil {
+			// The swap may still be mined, so it keeps counting as sold until it is settled.
+			logrus.Warnf("failed to settle slice %d of scheduled order %s: %v", slice.Index, id, err)
+			s.update(id, func(order *Order) {
+				order.Fills[slice.Index].Error = err.Error()
+			})
+			return !stopping(s.ctx, time.Minute)
+		}
+
+		s.update(id, func(order *Order) {
+			recordFill(order, slice.Index, resp)
+		})
+	}
+
+	return true
+}
+
+// slice sends the next slice of an order. Its size is an even share of the remaining amount over
+// the remaining slices, scaled by the order's size factor. A slice quoting above the order's price
+// impact target is halved until it fits, or skipped, and the size factor follows the impact seen.
+func (s *Scheduler) slice(id string) {
+	order, err := s.Get(id)
+	if err != nil || order.Done() {
+		return
+	}
+
+	remaining := order.Remaining()
+	if remaining.Sign() <= 0 {
+		// A pending swap may still fail, the order completes once it is settled.
+		if !hasPending(order) {
+			s.finish(id, StatusCompleted)
+		}
+		return
+	}
+
+	now := time.Now()
+	interval := order.Interval()
+	left := int((order.EndsAt.Sub(now) + interval - 1) / interval)
+	if left <= 0 {
+		s.finish(id, StatusExpired)
+		return
+	}
+
+	size := new(big.Int).Set(remaining)
+	if left > 1 {
+		size.Mul(size, big.NewInt(int64(order.SizeFactor*1000)))
+		size.Div(size, big.NewInt(int64(left)*1000))
+		if size.Sign() == 0 || size.Cmp(remaining) > 0 {
+			size.Set(remaining)
+		}
+	}
+
+	factor := order.SizeFactor
+	slice := Slice{Index: len(order.Fills), ExecutedAt: now}
+
+	var quote *quoteswap.GetQuoteResponse
+	for shrinks := 0; ; shrinks++ {
+		quote, err = s.executor.GetQuote(s.ctx, &quoteswap.GetQuoteRequest{
+			TokenIn:     order.TokenIn,
+			TokenOut:    order.TokenOut,
+			AmountIn:    size.String(),
+			Dex:         order.Dex,
+			Chain:       order.Chain,
+			SlippageBps: order.SlippageBps,
+		})
+		if err != nil {
+			break
+		}
+
+		impact, _ := strconv.ParseFloat(quote.GetPriceImpactBps(), 64)
+		if order.MaxSliceImpactBps == 0 || impact <= order.MaxSliceImpactBps {
+			if impact < order.MaxSliceImpactBps/2 {
+				factor = min(maxSizeFactor, factor*1.25)
+			}
+			break
+		}
+
+		factor = max(minSizeFactor, factor/2)
+		if shrinks == maxShrinks || size.Cmp(big.NewInt(1)) <= 0 {
+			err = fmt.Errorf("price impact of %s bps exceeds %.2f bps", quote.GetPriceImpactBps(), order.MaxSliceImpactBps)
+			break
+		}
+		size.Rsh(size, 1)
+	}
+
+	slice.AmountIn = size
+	if quote != nil {
+		slice.QuotedOut, _ = new(big.Int).SetString(quote.GetOutAmount(), 10)
+		slice.PriceImpactBps = quote.GetPriceImpactBps()
+	}
+
+	if err != nil {
+		logrus.Warnf("Skipping slice %d of scheduled order %s: %v", slice.Index, id, err)
+		slice.Status, slice.Error = SliceSkipped, err.Error()
+		s.record(id, slice, factor, false)
+		return
+	}
+
+	// The quote's output is the swap's minimum, so it is floored by the order's slippage first.
+	slice, _ = sendSwap(s.ctx, s.executor, quote, order.SlippageBps, slice.Index, func(slice Slice) {
+		s.update(id, func(order *Order) {
+			order.Fills = putExecution(order.Fills, slice)
+		})
+	})
+	if slice.Status == SliceFailed {
+		logrus.Warnf("Slice %d of scheduled order %s failed: %s", slice.Index, id, slice.Error)
+	}
+
+	s.record(id, slice, factor, slice.Status == SliceFailed)
+}
+
+// record stores a slice of an order and schedules the next one.
+func (s *Scheduler) record(id string, slice Slice, factor float64, failed bool) {
+	s.update(id, func(order *Order) {
+		order.Fills = putExecution(order.Fills, slice)
+		order.SizeFactor = factor
+
+		order.NextAt = order.NextAt.Add(order.Interval())
+		if now := time.Now(); order.NextAt.Before(now) {
+			order.NextAt = now
+		}
+
+		if !failed {
+			return
+		}
+		order.Failures++
+		if order.Failures >= maxFailures && !order.Done() {
+			order.Status = StatusFailed
+			order.Error = fmt.Sprintf("%d slices failed in a row, last: %s", order.Failures, slice.Error)
+		}
+	})
+}
+
+// recordFill applies the settled swap in resp to a pending slice.
+func recordFill(order *Order, index int, resp *quoteswap.ExecuteTxResponse) {
+	slice := &order.Fills[index]
+	if !slice.settle(resp) {
+		order.Failures++
+		if order.Failures >= maxFailures && !order.Done() {
+			order.Status = StatusFailed
+			order.Error = fmt.Sprintf("%d slices failed in a row, last: %s", order.Failures, slice.Error)
+		}
+		return
+	}
+
+	order.FilledIn.Add(order.FilledIn, slice.FilledIn)
+	order.FilledOut.Add(order.FilledOut, slice.FilledOut)
+	order.Failures = 0
+
+	if order.Status == StatusActive && order.Remaining().Sign() <= 0 {
+		order.Status = StatusCompleted
+	}
+}
+
+// finish ends an active order with status.
+func (s *Scheduler) finish(id string, status Status) {
+	s.update(id, func(order *Order) {
+		if order.Done() {
+			return
+		}
+		order.Status = status
+		logrus.Infof("Scheduled order %s %s: sold %s of %s", id, status, order.FilledIn, order.AmountIn)
+	})
+}
+
+// update applies fn to an order and persists all orders.
+func (s *Scheduler) update(id string, fn func(order *Order)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	order, ok := s.orders[id]
+	if !ok {
+		return
+	}
+	fn(order)
+
+	if err := s.saveLocked(); err != nil {
+		logrus.Errorf("failed to persist scheduled orders: %v", err)
+	}
+}
+
+// saveLocked persists all orders, oldest first. The lock must be held.
+func (s *Scheduler) saveLocked() error {
+	orders := make([]*Order, 0, len(s.orders))
+	for _, order := range s.orders {
+		orders = append(orders, order)
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].CreatedAt.Before(orders[j].CreatedAt)
+	})
+
+	return s.store.save(orders)
+}
+
+func hasPending(order *Order) bool {
+	for _, slice := range order.Fills {
+		if slice.Status == SlicePending {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,157 @@
+package scheduler
+
+import (
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"grpc_cake/gen/go/quoteswap"
+)
+
+func TestRemaining(t *testing.T) {
+	tests := []struct {
+		name  string
+		fills []Slice
+		want  int64
+	}{
+		{"nothing sold", nil, 1200},
+		{"filled slice counts its fill", []Slice{{Status: SliceSuccess, AmountIn: big.NewInt(100), FilledIn: big.NewInt(99)}}, 1101},
+		{"pending slice counts as sold", []Slice{{Status: SlicePending, AmountIn: big.NewInt(100)}}, 1100},
+		{"failed and skipped slices do not count", []Slice{{Status: SliceFailed, AmountIn: big.NewInt(100)}, {Status: SliceSkipped, AmountIn: big.NewInt(100)}}, 1200},
+		{"all of them", []Slice{
+			{Status: SliceSuccess, AmountIn: big.NewInt(300), FilledIn: big.NewInt(300)},
+			{Status: SlicePending, AmountIn: big.NewInt(100)},
+			{Status: SliceFailed, AmountIn: big.NewInt(100)},
+		}, 800},
+	}
+
+	for _, tt := range tests {
+		order := &Order{AmountIn: big.NewInt(1200), Fills: tt.fills}
+		if got := order.Remaining(); got.Int64() != tt.want {
+			t.Errorf("%s: got %s, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestSliceSizing works one slice of a 1200 order over 12 hourly slices. The fake venue quotes a
+// price impact of a tenth of a bp per unit sold and an output of ten units per unit.
+func TestSliceSizing(t *testing.T) {
+	tests := []struct {
+		name      string
+		factor    float64
+		targetBps float64
+		elapsed   time.Duration
+		fills     []Slice
+
+		wantStatus SliceStatus
+		wantIn     int64
+		wantFactor float64
+	}{
+		{"even share", 1, 0, 0, nil, SlicePending, 100, 1},
+		{"scaled by the size factor", 1.5, 0, 0, nil, SlicePending, 150, 1.5},
+		{"share of the remaining amount", 1, 0, 0, []Slice{{Status: SliceSuccess, AmountIn: big.NewInt(400), FilledIn: big.NewInt(400)}}, SlicePending, 66, 1},
+		{"last slice sells the rest", 1, 0, 11*time.Hour + 30*time.Minute, nil, SlicePending, 1200, 1},
+		{"within the target", 1, 10, 0, nil, SlicePending, 100, 1},
+		{"well within the target grows the factor", 1, 40, 0, nil, SlicePending, 100, 1.25},
+		{"factor capped", 1.8, 100, 0, nil, SlicePending, 180, maxSizeFactor},
+		{"halved to fit the target", 1, 5, 0, nil, SlicePending, 50, 0.5},
+		{"skipped after three halvings", 1, 1, 0, nil, SliceSkipped, 12, minSizeFactor},
+	}
+
+	for _, tt := range tests {
+		executor := &fakeExecutor{
+			quote: func(req *quoteswap.GetQuoteRequest) (*quoteswap.GetQuoteResponse, error) {
+				in, _ := new(big.Int).SetString(req.GetAmountIn(), 10)
+				return &quoteswap.GetQuoteResponse{
+					InAmount:       in.String(),
+					OutAmount:      new(big.Int).Mul(in, big.NewInt(10)).String(),
+					PriceImpactBps: fmt.Sprintf("%.2f", float64(in.Int64())/10),
+				}, nil
+			},
+		}
+		s, err := New(filepath.Join(t.TempDir(), "orders.json"), executor)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		createdAt := time.Now().Add(-tt.elapsed)
+		s.orders["order"] = &Order{
+			ID:                "order",
+			Status:            StatusActive,
+			AmountIn:          big.NewInt(1200),
+			Slices:            12,
+			CreatedAt:         createdAt,
+			EndsAt:            createdAt.Add(12 * time.Hour),
+			NextAt:            createdAt,
+			SlippageBps:       50,
+			MaxSliceImpactBps: tt.targetBps,
+			SizeFactor:        tt.factor,
+			FilledIn:          new(big.Int),
+			FilledOut:         new(big.Int),
+			Fills:             tt.fills,
+		}
+
+		s.slice("order")
+
+		order, _ := s.Get("order")
+		slice := order.Fills[len(order.Fills)-1]
+		if slice.Status != tt.wantStatus || slice.AmountIn.Int64() != tt.wantIn || order.SizeFactor != tt.wantFactor {
+			t.Errorf("%s: got %s slice of %s with factor %v, want %s of %d with %v", tt.name, slice.Status, slice.AmountIn, order.SizeFactor, tt.wantStatus, tt.wantIn, tt.wantFactor)
+		}
+		if tt.wantStatus != SlicePending {
+			if len(executor.swaps) != 0 {
+				t.Errorf("%s: a skipped slice sent a swap", tt.name)
+			}
+			continue
+		}
+
+		if len(executor.swaps) != 1 {
+			t.Fatalf("%s: got %d swaps, want 1", tt.name, len(executor.swaps))
+		}
+		if want := fmt.Sprint(tt.wantIn * 10 * 9950 / 10000); executor.swaps[0].GetOutAmount() != want {
+			t.Errorf("%s: minimum output %s, want %s", tt.name, executor.swaps[0].GetOutAmount(), want)
+		}
+		if slice.TxHash != "0x01" || len(order.Fills) != len(tt.fills)+1 {
+			t.Errorf("%s: got hash %q and %d fills, want the sent slice recorded once", tt.name, slice.TxHash, len(order.Fills))
+		}
+	}
+}
+
+// TestSlicePersistedBeforeSend checks that a slice is on disk as pending before its swap is sent.
+func TestSlicePersistedBeforeSend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orders.json")
+	executor := &fakeExecutor{
+		quote: func(req *quoteswap.GetQuoteRequest) (*quoteswap.GetQuoteResponse, error) {
+			return &quoteswap.GetQuoteResponse{InAmount: req.GetAmountIn(), OutAmount: "1000"}, nil
+		},
+	}
+	executor.execute = func(*quoteswap.GetQuoteResponse) (*quoteswap.ExecuteTxResponse, error) {
+		persisted, err := (&store[*Order]{path: path}).load()
+		if err != nil || len(persisted) != 1 || len(persisted[0].Fills) != 1 {
+			t.Fatalf("got %v persisted orders (%v) while sending, want the order with its slice", len(persisted), err)
+		}
+		if slice := persisted[0].Fills[0]; slice.Status != SlicePending || slice.TxHash != "" {
+			t.Errorf("persisted slice is %s with hash %q, want pending without hash", slice.Status, slice.TxHash)
+		}
+		return &quoteswap.ExecuteTxResponse{TransactionHash: "0x02", Status: quoteswap.TransactionStatus_PENDING}, nil
+	}
+
+	s, err := New(path, executor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	s.orders["order"] = &Order{ID: "order", Status: StatusActive, AmountIn: big.NewInt(1200), Slices: 12, CreatedAt: now, EndsAt: now.Add(12 * time.Hour), NextAt: now, SizeFactor: 1, FilledIn: new(big.Int), FilledOut: new(big.Int)}
+
+	s.slice("order")
+
+	persisted, err := s.store.load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fills := persisted[0].Fills; len(fills) != 1 || fills[0].TxHash != "0x02" || fills[0].Status != SlicePending {
+		t.Errorf("persisted fills %+v, want one pending slice with hash 0x02", fills)
+	}
+}
@@ -0,0 +1,134 @@
+package scheduler
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultPath is where scheduled orders are persisted when SCHEDULER_STATE_PATH is not set.
+	DefaultPath = "data/scheduled_orders.json"
+	// DefaultLimitPath is where limit orders are persisted when LIMIT_ORDERS_PATH is not set.
+	DefaultLimitPath = "data/limit_orders.json"
+	// DefaultTriggerPath is where triggers are persisted when TRIGGERS_PATH is not set.
+	DefaultTriggerPath = "data/triggers.json"
+	// DefaultPlanPath is where DCA plans are persisted when DCA_PLANS_PATH is not set.
+	DefaultPlanPath = "data/dca_plans.json"
+)
+
+// store persists all orders of a kind to one JSON file. Every save rewrites the file through a
+// temporary file and a rename, so a crash never leaves a truncated file behind.
+type store[T any] struct {
+	path string
+}
+
+func (s *store[T]) load() ([]T, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", s.path, err)
+	}
+
+	var orders []T
+	if err := json.Unmarshal(data, &orders); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", s.path, err)
+	}
+
+	return orders, nil
+}
+
+func (s *store[T]) save(orders []T) error {
+	data, err := json.MarshalIndent(orders, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.path)
+}
+
+// audit appends trigger evaluations to a JSON lines file, which is never rewritten.
+type audit struct {
+	path string
+	mu   sync.Mutex
+}
+
+func (a *audit) append(evaluations []Evaluation) error {
+	if len(evaluations) == 0 {
+		return nil
+	}
+
+	var data []byte
+	for _, evaluation := range evaluations {
+		line, err := json.Marshal(evaluation)
+		if err != nil {
+			return err
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(a.path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// read calls fn with every evaluation in the file, oldest first, until fn fails.
+func (a *audit) read(fn func(evaluation Evaluation) error) error {
+	f, err := os.Open(a.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", a.path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var evaluation Evaluation
+		if err := json.Unmarshal(scanner.Bytes(), &evaluation); err != nil {
+			// A line cut short by a crash is skipped.
+			continue
+		}
+		if err := fn(evaluation); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// AuditPath is the evaluations file kept next to the triggers persisted at path.
+func AuditPath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + "_evaluations.jsonl"
+}
@@ -0,0 +1,581 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+)
+
+// maxRecentEvaluations is how many evaluations a trigger keeps, the audit file keeps all of them.
+const maxRecentEvaluations = 20
+
+var (
+	ErrTriggerNotFound = errors.New("trigger not found")
+	ErrTriggerDone     = errors.New("trigger is no longer active")
+)
+
+type TriggerKind string
+
+const (
+	// StopLoss fires once the price is at or below the trigger price.
+	StopLoss TriggerKind = "stop_loss"
+	// TakeProfit fires once the price is at or above the trigger price.
+	TakeProfit TriggerKind = "take_profit"
+)
+
+// Trigger sells AmountIn once the execution price of its quote crosses TriggerPrice, in whole
+// TokenOut per whole TokenIn. With a TwapWindow, the TWAP of TwapDex's pool must cross it too.
+type Trigger struct {
+	ID       string      `json:"id"`
+	Status   Status      `json:"status"`
+	Kind     TriggerKind `json:"kind"`
+	Chain    string      `json:"chain"`
+	Dex      string      `json:"dex"`
+	TokenIn  string      `json:"token_in"`
+	TokenOut string      `json:"token_out"`
+	AmountIn *big.Int    `json:"amount_in"`
+
+	TriggerPrice string `json:"trigger_price"`
+	// TwapDex is the venue whose pool oracle smooths the price, Dex unless Dex has none.
+	TwapDex     string    `json:"twap_dex,omitempty"`
+	TwapWindow  uint32    `json:"twap_window,omitempty"`
+	SlippageBps uint32    `json:"slippage_bps"`
+	CreatedAt   time.Time `json:"created_at"`
+	// ExpiresAt is zero for a trigger that never expires.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	Evaluations int          `json:"evaluations"`
+	Recent      []Evaluation `json:"recent,omitempty"`
+	// Failures counts consecutive failed executions.
+	Failures   int     `json:"failures,omitempty"`
+	Error      string  `json:"error,omitempty"`
+	Executions []Slice `json:"executions,omitempty"`
+}
+
+// Evaluation is a trigger's condition checked at one block.
+type Evaluation struct {
+	TriggerID   string    `json:"trigger_id"`
+	BlockNumber uint64    `json:"block_number"`
+	BlockTime   uint64    `json:"block_time"`
+	EvaluatedAt time.Time `json:"evaluated_at"`
+	QuotedOut   *big.Int  `json:"quoted_out,omitempty"`
+	Price       string    `json:"price,omitempty"`
+	TwapPrice   string    `json:"twap_price,omitempty"`
+	Met         bool      `json:"met"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// Done reports whether the trigger is no longer evaluated.
+func (t *Trigger) Done() bool {
+	return t.Status != StatusActive
+}
+
+// crossed reports whether price is on the firing side of the trigger price.
+func (t *Trigger) crossed(price string) (bool, error) {
+	p, ok := new(big.Rat).SetString(price)
+	if !ok {
+		return false, fmt.Errorf("invalid price %q", price)
+	}
+	target, ok := new(big.Rat).SetString(t.TriggerPrice)
+	if !ok {
+		return false, fmt.Errorf("invalid trigger price %q", t.TriggerPrice)
+	}
+
+	if t.Kind == StopLoss {
+		return p.Cmp(target) <= 0, nil
+	}
+
+	return p.Cmp(target) >= 0, nil
+}
+
+func (t *Trigger) pending() bool {
+	for _, execution := range t.Executions {
+		if execution.Status == SlicePending {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fail counts a failed execution and fails the trigger after maxFailures in a row.
+func (t *Trigger) fail(reason string) {
+	t.Failures++
+	if t.Failures >= maxFailures && !t.Done() {
+		t.Status = StatusFailed
+		t.Error = fmt.Sprintf("%d executions failed in a row, last: %s", t.Failures, reason)
+	}
+}
+
+func (t *Trigger) clone() *Trigger {
+	c := *t
+	c.AmountIn = copyInt(t.AmountIn)
+
+	c.Recent = make([]Evaluation, len(t.Recent))
+	for i, evaluation := range t.Recent {
+		evaluation.QuotedOut = copyInt(evaluation.QuotedOut)
+		c.Recent[i] = evaluation
+	}
+
+	c.Executions = make([]Slice, len(t.Executions))
+	for i, execution := range t.Executions {
+		execution.AmountIn = copyInt(execution.AmountIn)
+		execution.QuotedOut = copyInt(execution.QuotedOut)
+		execution.FilledIn = copyInt(execution.FilledIn)
+		execution.FilledOut = copyInt(execution.FilledOut)
+		execution.GasPaid = copyInt(execution.GasPaid)
+		c.Executions[i] = execution
+	}
+
+	return &c
+}
+
+// twapKey identifies a TWAP read once per check for all triggers sharing it.
+type twapKey struct {
+	dex, tokenIn, tokenOut string
+	window                 uint32
+}
+
+// TriggerBook evaluates stop-loss and take-profit triggers at every new head of their chain and
+// sells once a condition holds. Every evaluation is appended to an audit file, triggers are
+// persisted on every change like limit orders.
+type TriggerBook struct {
+	executor Executor
+	store    store[*Trigger]
+	audit    audit
+
+	mu       sync.Mutex
+	triggers map[string]*Trigger
+	// checking holds the chains whose triggers are being evaluated, heads arriving meanwhile are skipped.
+	checking map[string]bool
+	// executing holds the triggers with a swap being sent or settled.
+	executing map[string]bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewTriggerBook loads the triggers persisted at path, their evaluations are appended to auditPath.
+// Triggers are not evaluated until Start is called.
+func NewTriggerBook(path, auditPath string, executor Executor) (*TriggerBook, error) {
+	b := &TriggerBook{
+		executor:  executor,
+		store:     store[*Trigger]{path: path},
+		audit:     audit{path: auditPath},
+		triggers:  make(map[string]*Trigger),
+		checking:  make(map[string]bool),
+		executing: make(map[string]bool),
+	}
+
+	triggers, err := b.store.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, trigger := range triggers {
+		b.triggers[trigger.ID] = trigger
+	}
+	b.ctx, b.cancel = context.WithCancel(context.Background())
+
+	return b, nil
+}
+
+// Start evaluates the triggers of every chain in clients and settles swaps sent before a restart.
+func (b *TriggerBook) Start(clients map[string]*blockchain.Client) {
+	for chain, client := range clients {
+		client.OnNewHead(func(head blockchain.Block) {
+			b.onHead(chain, head)
+		})
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for id, trigger := range b.triggers {
+		if trigger.pending() {
+			logrus.Infof("Settling trigger %s", id)
+			b.executing[id] = true
+			b.wg.Add(1)
+			go func() {
+				defer b.wg.Done()
+				b.settle(id)
+			}()
+		}
+	}
+}
+
+// Stop stops evaluating triggers and waits for executions in flight. Swaps not yet mined stay
+// pending and are settled after a restart.
+func (b *TriggerBook) Stop() {
+	b.mu.Lock()
+	b.cancel()
+	b.mu.Unlock()
+
+	b.wg.Wait()
+}
+
+// Create adds a trigger to be evaluated from the next head of its chain.
+func (b *TriggerBook) Create(trigger Trigger) (*Trigger, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	trigger.ID = id
+	trigger.Status = StatusActive
+	trigger.CreatedAt = time.Now()
+	trigger.Recent = nil
+	trigger.Executions = nil
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.triggers[id] = &trigger
+	if err := b.saveLocked(); err != nil {
+		delete(b.triggers, id)
+		return nil, err
+	}
+
+	logrus.Infof("Created %s trigger %s: %s of %s for %s on %s %s at %s", trigger.Kind, id, trigger.AmountIn, trigger.TokenIn, trigger.TokenOut, trigger.Chain, trigger.Dex, trigger.TriggerPrice)
+
+	return trigger.clone(), nil
+}
+
+// Cancel stops evaluating an active trigger. A swap already sent is still settled.
+func (b *TriggerBook) Cancel(id string) (*Trigger, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	trigger, ok := b.triggers[id]
+	if !ok {
+		return nil, ErrTriggerNotFound
+	}
+	if trigger.Done() {
+		return trigger.clone(), ErrTriggerDone
+	}
+
+	trigger.Status = StatusCancelled
+	if err := b.saveLocked(); err != nil {
+		logrus.Errorf("failed to persist triggers: %v", err)
+	}
+
+	logrus.Infof("Cancelled trigger %s", id)
+
+	return trigger.clone(), nil
+}
+
+// Get returns a copy of the trigger.
+func (b *TriggerBook) Get(id string) (*Trigger, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	trigger, ok := b.triggers[id]
+	if !ok {
+		return nil, ErrTriggerNotFound
+	}
+
+	return trigger.clone(), nil
+}
+
+// Evaluations calls fn with every evaluation of a trigger in the audit file, oldest first.
+func (b *TriggerBook) Evaluations(id string, fn func(evaluation Evaluation) error) error {
+	if _, err := b.Get(id); err != nil {
+		return err
+	}
+
+	return b.audit.read(func(evaluation Evaluation) error {
+		if evaluation.TriggerID != id {
+			return nil
+		}
+		return fn(evaluation)
+	})
+}
+
+// onHead starts evaluating the triggers of chain at head on its own goroutine.
+func (b *TriggerBook) onHead(chain string, head blockchain.Block) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ctx.Err() != nil || b.checking[chain] {
+		return
+	}
+	b.checking[chain] = true
+
+	b.wg.Add(1)
+	go b.check(chain, head)
+}
+
+// check expires the triggers of chain past their expiry at head and evaluates the others against
+// one batch of quotes at head and, for smoothed triggers, the TWAPs ending there.
+func (b *TriggerBook) check(chain string, head blockchain.Block) {
+	defer b.wg.Done()
+	defer func() {
+		b.mu.Lock()
+		delete(b.checking, chain)
+		b.mu.Unlock()
+	}()
+
+	headTime := time.Unix(int64(head.Time), 0)
+
+	var triggers []*Trigger
+	expired := false
+	b.mu.Lock()
+	for _, trigger := range b.triggers {
+		if trigger.Chain != chain || trigger.Done() || b.executing[trigger.ID] {
+			continue
+		}
+		if !trigger.ExpiresAt.IsZero() && !headTime.Before(trigger.ExpiresAt) {
+			trigger.Status = StatusExpired
+			expired = true
+			logrus.Infof("Trigger %s expired at block %d", trigger.ID, head.Number)
+			continue
+		}
+		triggers = append(triggers, trigger.clone())
+	}
+	if expired {
+		if err := b.saveLocked(); err != nil {
+			logrus.Errorf("failed to persist triggers: %v", err)
+		}
+	}
+	b.mu.Unlock()
+
+	if len(triggers) == 0 {
+		return
+	}
+
+	req := &quoteswap.BatchGetQuoteRequest{}
+	for _, trigger := range triggers {
+		req.Quotes = append(req.Quotes, &quoteswap.GetQuoteRequest{
+			TokenIn:     trigger.TokenIn,
+			TokenOut:    trigger.TokenOut,
+			AmountIn:    trigger.AmountIn.String(),
+			Dex:         trigger.Dex,
+			Chain:       trigger.Chain,
+			SlippageBps: trigger.SlippageBps,
+			BlockNumber: head.Number,
+		})
+	}
+
+	resp, err := b.executor.BatchGetQuote(b.ctx, req)
+	if err != nil {
+		logrus.Warnf("failed to quote triggers on %s at block %d: %v", chain, head.Number, err)
+		return
+	}
+
+	twaps := make(map[twapKey]*quoteswap.GetTwapResponse)
+	twapErrs := make(map[twapKey]error)
+
+	evaluations := make([]Evaluation, 0, len(triggers))
+	for i, result := range resp.GetResults() {
+		trigger := triggers[i]
+		evaluation := Evaluation{
+			TriggerID:   trigger.ID,
+			BlockNumber: head.Number,
+			BlockTime:   head.Time,
+			EvaluatedAt: time.Now(),
+		}
+
+		quote := result.GetQuote()
+		evaluation.Met, err = b.evaluate(chain, trigger, result, twaps, twapErrs, &evaluation)
+		if err != nil {
+			evaluation.Error = err.Error()
+		}
+		evaluations = append(evaluations, evaluation)
+
+		b.mu.Lock()
+		current := b.triggers[trigger.ID]
+		current.Evaluations++
+		current.Recent = append(current.Recent, evaluation)
+		if len(current.Recent) > maxRecentEvaluations {
+			current.Recent = current.Recent[len(current.Recent)-maxRecentEvaluations:]
+		}
+		if evaluation.Met && !current.Done() && !b.executing[trigger.ID] {
+			b.executing[trigger.ID] = true
+			b.wg.Add(1)
+			go b.execute(trigger.ID, quote)
+		}
+		b.mu.Unlock()
+	}
+
+	if err := b.audit.append(evaluations); err != nil {
+		logrus.Errorf("failed to record trigger evaluations: %v", err)
+	}
+}
+
+// evaluate checks the condition of a trigger against its quote result and, when smoothed, the TWAP
+// of its pair, which is read once per check for all triggers sharing it.
+func (b *TriggerBook) evaluate(chain string, trigger *Trigger, result *quoteswap.BatchQuoteResult, twaps map[twapKey]*quoteswap.GetTwapResponse, twapErrs map[twapKey]error, evaluation *Evaluation) (bool, error) {
+	if result.GetError() != nil {
+		return false, errors.New(result.GetError().GetMessage())
+	}
+
+	quote := result.GetQuote()
+	evaluation.QuotedOut, _ = new(big.Int).SetString(quote.GetOutAmount(), 10)
+	evaluation.Price = quote.GetExecutionPrice()
+	if evaluation.Price == "" {
+		return false, errors.New("the quote has no execution price")
+	}
+
+	met, err := trigger.crossed(evaluation.Price)
+	if err != nil || trigger.TwapWindow == 0 {
+		return met, err
+	}
+
+	key := twapKey{dex: trigger.TwapDex, tokenIn: trigger.TokenIn, tokenOut: trigger.TokenOut, window: trigger.TwapWindow}
+	twap, ok := twaps[key]
+	if !ok && twapErrs[key] == nil {
+		twap, err = b.executor.GetTwap(b.ctx, &quoteswap.GetTwapRequest{
+			Chain:         chain,
+			Dex:           trigger.TwapDex,
+			TokenA:        trigger.TokenIn,
+			TokenB:        trigger.TokenOut,
+			WindowSeconds: trigger.TwapWindow,
+		})
+		if err != nil {
+			twapErrs[key] = err
+		} else {
+			twaps[key] = twap
+		}
+	}
+	if err := twapErrs[key]; err != nil {
+		return false, fmt.Errorf("failed to read the TWAP: %w", err)
+	}
+
+	evaluation.TwapPrice = twap.GetPrice()
+	if evaluation.TwapPrice == "" {
+		return false, errors.New("the TWAP has no price")
+	}
+
+	twapMet, err := trigger.crossed(evaluation.TwapPrice)
+
+	return met && twapMet, err
+}
+
+// execute sends the swap of a trigger whose condition held at quote and settles it. The execution
+// is persisted as pending before the swap is sent.
+func (b *TriggerBook) execute(id string, quote *quoteswap.GetQuoteResponse) {
+	defer b.wg.Done()
+
+	trigger, err := b.Get(id)
+	if err != nil {
+		b.release(id)
+		return
+	}
+
+	logrus.Infof("Trigger %s fired at block %d: price %s, trigger price %s", id, quote.GetBlockNumber(), quote.GetExecutionPrice(), trigger.TriggerPrice)
+
+	execution, _ := sendSwap(b.ctx, b.executor, quote, trigger.SlippageBps, len(trigger.Executions), func(execution Slice) {
+		b.update(id, func(trigger *Trigger) {
+			trigger.Executions = putExecution(trigger.Executions, execution)
+		})
+	})
+	b.update(id, func(trigger *Trigger) {
+		trigger.Executions = putExecution(trigger.Executions, execution)
+		if execution.Status == SliceFailed {
+			logrus.Warnf("Execution of trigger %s failed: %s", id, execution.Error)
+			trigger.fail(execution.Error)
+		}
+	})
+
+	if execution.Status != SlicePending {
+		b.release(id)
+		return
+	}
+
+	b.settle(id)
+}
+
+// settle waits for the pending swap of a trigger to be mined and records its fill. A reverted swap,
+// or one not mined within pendingTimeout, leaves the trigger active to fire again while its
+// condition holds.
+func (b *TriggerBook) settle(id string) {
+	for {
+		trigger, err := b.Get(id)
+		if err != nil {
+			b.release(id)
+			return
+		}
+
+		index := len(trigger.Executions) - 1
+		if index < 0 || trigger.Executions[index].Status != SlicePending {
+			b.release(id)
+			return
+		}
+
+		resp, err := awaitSwap(b.ctx, b.executor, trigger.Chain, trigger.TokenIn, trigger.TokenOut, trigger.Executions[index])
+		if b.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logrus.Warnf("failed to settle trigger %s: %v", id, err)
+			b.update(id, func(trigger *Trigger) {
+				trigger.Executions[index].Error = err.Error()
+			})
+			if stopping(b.ctx, time.Minute) {
+				return
+			}
+			continue
+		}
+
+		b.update(id, func(trigger *Trigger) {
+			execution := &trigger.Executions[index]
+			if !execution.settle(resp) {
+				logrus.Warnf("Swap of trigger %s failed: %s", id, execution.Error)
+				trigger.fail(execution.Error)
+				return
+			}
+
+			// A swap sent before the trigger was cancelled or expired still filled it.
+			trigger.Status = StatusCompleted
+			trigger.Failures = 0
+			logrus.Infof("Trigger %s filled: sold %s of %s for %s of %s", id, execution.FilledIn, trigger.TokenIn, execution.FilledOut, trigger.TokenOut)
+		})
+		b.release(id)
+		return
+	}
+}
+
+// release lets the trigger be evaluated again.
+func (b *TriggerBook) release(id string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.executing, id)
+}
+
+// update applies fn to a trigger and persists all triggers.
+func (b *TriggerBook) update(id string, fn func(trigger *Trigger)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	trigger, ok := b.triggers[id]
+	if !ok {
+		return
+	}
+	fn(trigger)
+
+	if err := b.saveLocked(); err != nil {
+		logrus.Errorf("failed to persist triggers: %v", err)
+	}
+}
+
+// saveLocked persists all triggers, oldest first. The lock must be held.
+func (b *TriggerBook) saveLocked() error {
+	triggers := make([]*Trigger, 0, len(b.triggers))
+	for _, trigger := range b.triggers {
+		triggers = append(triggers, trigger)
+	}
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i].CreatedAt.Before(triggers[j].CreatedAt)
+	})
+
+	return b.store.save(triggers)
+}
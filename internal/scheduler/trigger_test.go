@@ -0,0 +1,42 @@
+package scheduler
+
+import "testing"
+
+func TestCrossed(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    TriggerKind
+		trigger string
+		price   string
+		want    bool
+		wantErr bool
+	}{
+		{"stop loss below", StopLoss, "300", "299.5", true, false},
+		{"stop loss at", StopLoss, "300", "300", true, false},
+		{"stop loss above", StopLoss, "300", "300.01", false, false},
+		{"take profit below", TakeProfit, "300", "299.99", false, false},
+		{"take profit at", TakeProfit, "300", "300.0", true, false},
+		{"take profit above", TakeProfit, "300", "301", true, false},
+		{"fractional trigger price", StopLoss, "0.0025", "0.00249", true, false},
+		{"invalid price", StopLoss, "300", "abc", false, true},
+		{"empty price", TakeProfit, "300", "", false, true},
+		{"invalid trigger price", TakeProfit, "3O0", "300", false, true},
+	}
+
+	for _, tt := range tests {
+		trigger := &Trigger{Kind: tt.kind, TriggerPrice: tt.trigger}
+		got, err := trigger.crossed(tt.price)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %t", tt.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
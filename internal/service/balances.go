@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+	erc20 "grpc_cake/internal/blockchain/abi/gen/erc20"
+	"grpc_cake/internal/blockchain/abi/gen/multicall3"
+	"grpc_cake/internal/token"
+)
+
+var (
+	erc20ABI, _      = erc20.BlockchainMetaData.GetAbi()
+	multicall3ABI, _ = multicall3.BlockchainMetaData.GetAbi()
+)
+
+// GetBalances returns the native and ERC-20 balances of the configured wallets on every requested
+// chain. Balances are read with one Multicall3 call per chain and, when a quote token is given for
+// the chain, valued with the best of the service's own V2 and V3 quotes.
+func (s *QuoteSwapServiceServer) GetBalances(ctx context.Context, req *quoteswap.GetBalancesRequest) (*quoteswap.GetBalancesResponse, error) {
+	if len(s.Wallets) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no wallets configured, set WALLET_ADDRS or RECIPIENT_ADDR")
+	}
+
+	chains := req.GetChains()
+	if len(chains) == 0 {
+		for chain := range s.Clients {
+			chains = append(chains, chain)
+		}
+		sort.Strings(chains)
+	}
+
+	tokensByChain := make(map[string][]common.Address)
+	for _, ref := range req.GetTokens() {
+		if !common.IsHexAddress(ref.GetAddress()) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid token address: %s", ref.GetAddress())
+		}
+		tokensByChain[ref.GetChain()] = append(tokensByChain[ref.GetChain()], common.HexToAddress(ref.GetAddress()))
+	}
+
+	wallets := make([]*quoteswap.WalletBalances, len(s.Wallets))
+	for i, wallet := range s.Wallets {
+		wallets[i] = &quoteswap.WalletBalances{
+			Wallet:            wallet.Hex(),
+			TotalValueDecimal: make(map[string]string),
+		}
+	}
+
+	for _, chain := range chains {
+		balances, err := s.readBalances(ctx, chain, tokensByChain[chain])
+		if err != nil {
+			for _, wallet := range wallets {
+				wallet.Balances = append(wallet.Balances, &quoteswap.Balance{
+					Chain: chain,
+					Error: &quoteswap.Error{Code: int32(status.Code(err)), Message: err.Error()},
+				})
+			}
+			continue
+		}
+
+		for i, wallet := range wallets {
+			wallet.Balances = append(wallet.Balances, balances[i]...)
+		}
+	}
+
+	for chain, quoteToken := range req.GetQuoteTokens() {
+		if !common.IsHexAddress(quoteToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quote token address: %s", quoteToken)
+		}
+		s.valueBalances(ctx, chain, common.HexToAddress(quoteToken), wallets)
+	}
+
+	return &quoteswap.GetBalancesResponse{Wallets: wallets}, nil
+}
+
+// readBalances reads the native balance and the given token balances of every wallet on chain.
+// The result is indexed like s.Wallets.
+func (s *QuoteSwapServiceServer) readBalances(ctx context.Context, chain string, tokens []common.Address) ([][]*quoteswap.Balance, error) {
+	client, ok := s.Clients[chain]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no client found for chain: %s", chain)
+	}
+
+	var calls []blockchain.Call
+	for _, wallet := range s.Wallets {
+		callData, err := multicall3ABI.Pack("getEthBalance", wallet)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, blockchain.Call{Target: client.Multicall3Address(), CallData: callData})
+
+		for _, tokenAddress := range tokens {
+			callData, err := erc20ABI.Pack("balanceOf", wallet)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, blockchain.Call{Target: tokenAddress, CallData: callData})
+		}
+	}
+
+	results, err := client.Multicall(ctx, &bind.CallOpts{Context: ctx}, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([][]*quoteswap.Balance, len(s.Wallets))
+	next := 0
+	for i := range s.Wallets {
+		native := &quoteswap.Balance{Chain: chain, Symbol: client.Config.NativeSymbol}
+		setBalance(native, multicall3ABI, "getEthBalance", results[next], client.Config.NativeDecimals)
+		balances[i] = append(balances[i], native)
+		next++
+
+		for _, tokenAddress := range tokens {
+			balance := &quoteswap.Balance{Chain: chain, Token: tokenAddress.Hex()}
+			result := results[next]
+			next++
+
+			meta, err := s.Tokens.Get(ctx, chain, tokenAddress)
+			if err != nil {
+				balance.Error = &quoteswap.Error{Code: int32(status.Code(err)), Message: err.Error()}
+				balances[i] = append(balances[i], balance)
+				continue
+			}
+
+			balance.Symbol = meta.Symbol
+			setBalance(balance, erc20ABI, "balanceOf", result, meta.Decimals)
+			balances[i] = append(balances[i], balance)
+		}
+	}
+
+	return balances, nil
+}
+
+func setBalance(balance *quoteswap.Balance, contractABI *abi.ABI, method string, result blockchain.CallResult, decimals uint8) {
+	if !result.Success {
+		balance.Error = &quoteswap.Error{Code: int32(codes.Unknown), Message: fmt.Sprintf("%s reverted", method)}
+		return
+	}
+
+	out, err := contractABI.Unpack(method, result.ReturnData)
+	if err != nil {
+		balance.Error = &quoteswap.Error{Code: int32(codes.Unknown), Message: err.Error()}
+		return
+	}
+
+	amount := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	balance.Amount = amount.String()
+	balance.AmountDecimal = token.FormatUnits(amount, decimals)
+}
+
+// valueBalances values the balances on chain in quoteToken, using the better of the V2 and V3
+// quotes for each balance, and sums the values per wallet.
+func (s *QuoteSwapServiceServer) valueBalances(ctx context.Context, chain string, quoteToken common.Address, wallets []*quoteswap.WalletBalances) {
+	client, ok := s.Clients[chain]
+	if !ok {
+		return
+	}
+
+	quoteDecimals, err := s.Tokens.Decimals(ctx, chain, quoteToken)
+	if err != nil {
+		return
+	}
+
+	type pricing struct {
+		balance *quoteswap.Balance
+		index   int
+	}
+
+	var (
+		pending []pricing
+		quotes  []*quoteswap.GetQuoteRequest
+	)
+	for _, wallet := range wallets {
+		for _, balance := range wallet.Balances {
+			if balance.GetChain() != chain || balance.GetError() != nil || balance.GetAmount() == "" {
+				continue
+			}
+			balance.ValueToken = quoteToken.Hex()
+
+			tokenIn := common.HexToAddress(balance.GetToken())
+			if balance.GetToken() == "" {
+				tokenIn = client.Config.WrappedNative
+			}
+
+			switch {
+			case balance.GetAmount() == "0":
+				balance.Value = "0"
+				continue
+			case tokenIn == quoteToken:
+				balance.Value = balance.GetAmount()
+				continue
+			}
+
+			pending = append(pending, pricing{balance: balance, index: len(quotes)})
+			for _, dex := range []string{"v2", "v3"} {
+				quotes = append(quotes, &quoteswap.GetQuoteRequest{
+					TokenIn:  tokenIn.Hex(),
+					TokenOut: quoteToken.Hex(),
+					AmountIn: balance.GetAmount(),
+					Dex:      dex,
+					Chain:    chain,
+				})
+			}
+		}
+	}
+
+	if len(quotes) > 0 {
+		batch, err := s.BatchGetQuote(ctx, &quoteswap.BatchGetQuoteRequest{Quotes: quotes})
+		if err != nil {
+			return
+		}
+
+		for _, p := range pending {
+			var best *big.Int
+			for _, result := range batch.GetResults()[p.index : p.index+2] {
+				out, ok := new(big.Int).SetString(result.GetQuote().GetOutAmount(), 10)
+				if ok && (best == nil || out.Cmp(best) > 0) {
+					best = out
+				}
+			}
+			if best != nil {
+				p.balance.Value = best.String()
+			}
+		}
+	}
+
+	for _, wallet := range wallets {
+		total := new(big.Int)
+		for _, balance := range wallet.Balances {
+			if balance.GetChain() != chain || balance.GetValue() == "" {
+				continue
+			}
+
+			value, _ := new(big.Int).SetString(balance.GetValue(), 10)
+			balance.ValueDecimal = token.FormatUnits(value, quoteDecimals)
+			total.Add(total, value)
+		}
+		wallet.TotalValueDecimal[chain] = token.FormatUnits(total, quoteDecimals)
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// batchItem is a quote request from a batch waiting for the multicall of its chain and block.
+type batchItem struct {
+	index   int
+	req     *quoteswap.GetQuoteRequest
+	block   blockchain.Block
+	swapper pancakeswap.Swapper
+	calls   *pancakeswap.QuoteCalls
+}
+
+// batchKey groups batch items quoted with one multicall.
+type batchKey struct {
+	chain string
+	block blockchain.Block
+}
+
+// BatchGetQuote quotes all requests at once. Calls for the same chain and block are aggregated into
+// Multicall3 aggregate3 calls, a failing item is reported in its result without failing the batch.
+func (s *QuoteSwapServiceServer) BatchGetQuote(ctx context.Context, req *quoteswap.BatchGetQuoteRequest) (*quoteswap.BatchGetQuoteResponse, error) {
+	results := make([]*quoteswap.BatchQuoteResult, len(req.GetQuotes()))
+	byBlock := make(map[batchKey][]batchItem)
+
+	for i, quoteReq := range req.GetQuotes() {
+		service, err := s.Venues.Swapper(ctx, quoteReq.GetChain(), quoteReq.GetDex())
+		if err != nil {
+			results[i] = batchError(err)
+			continue
+		}
+
+		quoteReq, block, err := s.normalizeQuoteRequest(ctx, quoteReq)
+		if err != nil {
+			results[i] = batchError(err)
+			continue
+		}
+
+		if resp := s.Quotes.Get(quoteReq, block); resp != nil {
+			results[i] = batchResult(resp, nil)
+			continue
+		}
+
+		batcher, ok := service.(pancakeswap.BatchQuoter)
+		if !ok || s.Clients[quoteReq.GetChain()] == nil {
+			resp, err := service.GetQuote(ctx, quoteReq)
+			if err == nil {
+				setBlock(resp, block)
+				s.priceQuote(ctx, service, quoteReq, resp)
+				s.Quotes.Put(quoteReq, block, resp)
+			}
+			results[i] = batchResult(resp, err)
+			continue
+		}
+
+		calls, err := batcher.QuoteCalls(quoteReq)
+		if err != nil {
+			results[i] = batchError(err)
+			continue
+		}
+
+		key := batchKey{chain: quoteReq.GetChain(), block: block}
+		byBlock[key] = append(byBlock[key], batchItem{index: i, req: quoteReq, block: block, swapper: service, calls: calls})
+	}
+
+	for key, items := range byBlock {
+		var calls []blockchain.Call
+		for _, item := range items {
+			calls = append(calls, item.calls.Calls...)
+		}
+
+		logrus.Infof("Batch quoting %d requests on %s at block %d with %d calls", len(items), key.chain, key.block.Number, len(calls))
+
+		callResults, err := s.Clients[key.chain].Multicall(ctx, pancakeswap.CallOpts(ctx, items[0].req), calls)
+		if err != nil {
+			for _, item := range items {
+				results[item.index] = batchError(err)
+			}
+			continue
+		}
+
+		offset := 0
+		for _, item := range items {
+			n := len(item.calls.Calls)
+			resp, err := item.calls.Decode(callResults[offset : offset+n])
+			if err == nil {
+				setBlock(resp, item.block)
+				s.priceQuote(ctx, item.swapper, item.req, resp)
+				s.Quotes.Put(item.req, item.block, resp)
+			}
+			results[item.index] = batchResult(resp, err)
+			offset += n
+		}
+	}
+
+	for _, result := range results {
+		s.decorateQuote(ctx, result.GetQuote())
+	}
+
+	return &quoteswap.BatchGetQuoteResponse{Results: results}, nil
+}
+
+func batchResult(resp *quoteswap.GetQuoteResponse, err error) *quoteswap.BatchQuoteResult {
+	if err != nil {
+		return batchError(err)
+	}
+
+	return &quoteswap.BatchQuoteResult{Quote: resp}
+}
+
+func batchError(err error) *quoteswap.BatchQuoteResult {
+	return &quoteswap.BatchQuoteResult{
+		Error: &quoteswap.Error{
+			Code:    int32(status.Code(err)),
+			Message: err.Error(),
+		},
+	}
+}
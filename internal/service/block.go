@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+)
+
+// resolveBlock returns the block a quote request is computed at: the requested block hash or
+// number, or the chain head when the request does not pin one.
+func (s *QuoteSwapServiceServer) resolveBlock(ctx context.Context, req *quoteswap.GetQuoteRequest) (blockchain.Block, error) {
+	client, ok := s.Clients[req.GetChain()]
+	if !ok {
+		return blockchain.Block{}, status.Errorf(codes.NotFound, "no client found for chain: %s", req.GetChain())
+	}
+
+	var (
+		header *types.Header
+		err    error
+	)
+	switch {
+	case req.GetBlockHash() != "":
+		hash, decodeErr := decodeHash(req.GetBlockHash())
+		if decodeErr != nil {
+			return blockchain.Block{}, decodeErr
+		}
+		header, err = client.Eth().HeaderByHash(ctx, hash)
+	case req.GetBlockNumber() != 0:
+		header, err = client.Eth().HeaderByNumber(ctx, new(big.Int).SetUint64(req.GetBlockNumber()))
+	default:
+		if head := client.Head(); head.Number != 0 {
+			return head, nil
+		}
+		header, err = client.Eth().HeaderByNumber(ctx, nil)
+	}
+	if errors.Is(err, ethereum.NotFound) {
+		return blockchain.Block{}, status.Errorf(codes.NotFound, "block not found on %s", req.GetChain())
+	}
+	if err != nil {
+		return blockchain.Block{}, err
+	}
+
+	block := blockchain.Block{Number: header.Number.Uint64(), Hash: header.Hash(), Time: header.Time}
+	if req.GetBlockHash() != "" && req.GetBlockNumber() != 0 && req.GetBlockNumber() != block.Number {
+		return blockchain.Block{}, status.Errorf(codes.InvalidArgument, "block %s is number %d, not %d", block.Hash.Hex(), block.Number, req.GetBlockNumber())
+	}
+
+	return block, nil
+}
+
+// setBlock records the block a quote was computed at.
+func setBlock(resp *quoteswap.GetQuoteResponse, block blockchain.Block) {
+	resp.BlockNumber = block.Number
+	resp.BlockHash = block.Hash.Hex()
+	resp.BlockTimestamp = block.Time
+}
+
+func decodeHash(hash string) (common.Hash, error) {
+	raw, err := hexutil.Decode(hash)
+	if err != nil || len(raw) != common.HashLength {
+		return common.Hash{}, status.Errorf(codes.InvalidArgument, "invalid block hash: %s", hash)
+	}
+
+	return common.BytesToHash(raw), nil
+}
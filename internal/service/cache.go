@@ -0,0 +1,123 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// maxStalenessBlocks caps GetQuoteRequest.max_staleness_blocks, quotes older than this many blocks
+// behind the head are dropped from the cache.
+const maxStalenessBlocks = 20
+
+// QuoteCache keeps quotes per block, so identical requests within a block are quoted once.
+// Entries are dropped as new heads arrive. A quote is only served at its own block by hash, so a
+// quote of one fork is never served for the same height of another.
+type QuoteCache struct {
+	mu     sync.RWMutex
+	quotes map[quoteKey]*quoteswap.GetQuoteResponse
+}
+
+type quoteKey struct {
+	chain    string
+	dex      string
+	tokenIn  common.Address
+	tokenOut common.Address
+	amount   string
+	block    uint64
+}
+
+func NewQuoteCache(clients map[string]*blockchain.Client) *QuoteCache {
+	cache := &QuoteCache{quotes: make(map[quoteKey]*quoteswap.GetQuoteResponse)}
+
+	for chain, client := range clients {
+		client.OnNewHead(func(head blockchain.Block) {
+			cache.evict(chain, head.Number)
+		})
+	}
+
+	return cache
+}
+
+// Get returns a copy of a quote for req cached at block or up to req.max_staleness_blocks before it.
+func (c *QuoteCache) Get(req *quoteswap.GetQuoteRequest, block blockchain.Block) *quoteswap.GetQuoteResponse {
+	head := block.Number
+	if c == nil || head == 0 {
+		return nil
+	}
+
+	key, ok := newQuoteKey(req, head)
+	if !ok {
+		return nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	staleness := uint64(min(req.GetMaxStalenessBlocks(), maxStalenessBlocks))
+	for key.block+staleness >= head && key.block > 0 {
+		// Blocks before block are only served to requests accepting stale quotes, which pin no hash.
+		if quote, ok := c.quotes[key]; ok && (key.block != head || quote.GetBlockHash() == block.Hash.Hex()) {
+			resp := proto.Clone(quote).(*quoteswap.GetQuoteResponse)
+			resp.SlippageBps = int32(req.GetSlippageBps())
+			resp.CacheHit = true
+			return resp
+		}
+		key.block--
+	}
+
+	return nil
+}
+
+// Put caches a copy of the quote for req computed at block, replacing a quote of another block at
+// the same height.
+func (c *QuoteCache) Put(req *quoteswap.GetQuoteRequest, block blockchain.Block, resp *quoteswap.GetQuoteResponse) {
+	if c == nil || block.Number == 0 || resp == nil {
+		return
+	}
+
+	key, ok := newQuoteKey(req, block.Number)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	quote := proto.Clone(resp).(*quoteswap.GetQuoteResponse)
+	quote.BlockHash = block.Hash.Hex()
+	c.quotes[key] = quote
+}
+
+// evict drops the quotes of chain that are too old to be served at head.
+func (c *QuoteCache) evict(chain string, head uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.quotes {
+		if key.chain == chain && key.block+maxStalenessBlocks < head {
+			delete(c.quotes, key)
+		}
+	}
+}
+
+// newQuoteKey keys req by its quote parameters, it fails when req has no valid amount.
+func newQuoteKey(req *quoteswap.GetQuoteRequest, block uint64) (quoteKey, bool) {
+	amount, err := pancakeswap.AmountIn(req)
+	if err != nil {
+		return quoteKey{}, false
+	}
+
+	return quoteKey{
+		chain:    req.GetChain(),
+		dex:      req.GetDex(),
+		tokenIn:  common.HexToAddress(req.GetTokenIn()),
+		tokenOut: common.HexToAddress(req.GetTokenOut()),
+		amount:   amount.String(),
+		block:    block,
+	}, true
+}
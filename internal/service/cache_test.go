@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+)
+
+func TestQuoteCache(t *testing.T) {
+	block := func(number uint64, fork byte) blockchain.Block {
+		return blockchain.Block{Number: number, Hash: common.BytesToHash([]byte{byte(number), fork})}
+	}
+	request := func(staleness uint32) *quoteswap.GetQuoteRequest {
+		return &quoteswap.GetQuoteRequest{
+			TokenIn:            "0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c",
+			TokenOut:           "0x55d398326f99059fF775485246999027B3197955",
+			AmountIn:           "1000",
+			Dex:                "v3",
+			Chain:              "bsc",
+			SlippageBps:        30,
+			MaxStalenessBlocks: staleness,
+		}
+	}
+
+	tests := []struct {
+		name string
+		req  *quoteswap.GetQuoteRequest
+		at   blockchain.Block
+		hit  bool
+	}{
+		{"same block and hash", request(0), block(100, 0), true},
+		{"same height on another fork", request(0), block(100, 1), false},
+		{"same height on another fork accepting stale quotes", request(5), block(100, 1), false},
+		{"later block without staleness", request(0), block(101, 0), false},
+		{"later block within the staleness", request(2), block(102, 0), true},
+		{"later block past the staleness", request(2), block(103, 0), false},
+		{"staleness capped at the maximum", request(1000), block(100+maxStalenessBlocks, 0), true},
+		{"past the capped staleness", request(1000), block(101+maxStalenessBlocks, 0), false},
+		{"earlier block", request(5), block(99, 0), false},
+	}
+
+	cache := NewQuoteCache(nil)
+	cache.Put(request(0), block(100, 0), &quoteswap.GetQuoteResponse{OutAmount: "987", SlippageBps: 10})
+
+	for _, tt := range tests {
+		got := cache.Get(tt.req, tt.at)
+		if (got != nil) != tt.hit {
+			t.Errorf("%s: got hit %t, want %t", tt.name, got != nil, tt.hit)
+			continue
+		}
+		if got != nil && (got.GetOutAmount() != "987" || !got.GetCacheHit() || got.GetSlippageBps() != 30) {
+			t.Errorf("%s: got out %s cache hit %t slippage %d, want 987, true and the request's 30", tt.name, got.GetOutAmount(), got.GetCacheHit(), got.GetSlippageBps())
+		}
+	}
+
+	// Another amount is another quote.
+	other := request(0)
+	other.AmountIn = "1001"
+	if cache.Get(other, block(100, 0)) != nil {
+		t.Error("another amount: got a hit")
+	}
+
+	// A quote of another fork at the same height replaces the cached one.
+	cache.Put(request(0), block(100, 1), &quoteswap.GetQuoteResponse{OutAmount: "986"})
+	if got := cache.Get(request(0), block(100, 1)); got.GetOutAmount() != "986" {
+		t.Errorf("replaced quote: got out %s, want 986", got.GetOutAmount())
+	}
+	if cache.Get(request(0), block(100, 0)) != nil {
+		t.Error("replaced quote: the other fork's hash still hits")
+	}
+
+	cache.evict("eth", 200)
+	if cache.Get(request(1000), block(100+maxStalenessBlocks, 0)) == nil {
+		t.Error("evicting another chain dropped the quote")
+	}
+	cache.evict("bsc", 101+maxStalenessBlocks)
+	if cache.Get(request(1000), block(100+maxStalenessBlocks, 0)) != nil {
+		t.Error("evicting the chain past the maximum staleness kept the quote")
+	}
+}
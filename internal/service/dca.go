@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/scheduler"
+)
+
+// CreateDcaPlan starts a plan buying token_out for amount_in of token_in at every run of a cron
+// schedule.
+func (s *QuoteSwapServiceServer) CreateDcaPlan(ctx context.Context, req *quoteswap.CreateDcaPlanRequest) (*quoteswap.DcaPlan, error) {
+	if s.Planner == nil {
+		return nil, status.Error(codes.Unimplemented, "DCA plans are not enabled")
+	}
+
+	if !common.IsHexAddress(req.GetTokenIn()) || !common.IsHexAddress(req.GetTokenOut()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token pair: %s, %s", req.GetTokenIn(), req.GetTokenOut())
+	}
+	tokenIn, tokenOut := common.HexToAddress(req.GetTokenIn()), common.HexToAddress(req.GetTokenOut())
+	if tokenIn == tokenOut {
+		return nil, status.Error(codes.InvalidArgument, "token_in and token_out must differ")
+	}
+
+	amountIn, err := pancakeswap.ParseAmount(req.GetAmountIn())
+	if err != nil || amountIn.Sign() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount in: %q", req.GetAmountIn())
+	}
+	if _, err := scheduler.ParseSchedule(req.GetSchedule()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+	}
+	if req.GetMaxPrice() != "" {
+		if price, ok := new(big.Rat).SetString(req.GetMaxPrice()); !ok || price.Sign() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid max price: %q", req.GetMaxPrice())
+		}
+	}
+	var endsAt time.Time
+	if req.GetEndsAt() != 0 {
+		endsAt = time.Unix(int64(req.GetEndsAt()), 0)
+		if !endsAt.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "ends_at must be in the future")
+		}
+	}
+	if req.GetSlippageBps() >= 10000 {
+		return nil, status.Error(codes.InvalidArgument, "slippage_bps must be below 10000")
+	}
+
+	if req.GetDex() == scheduler.DexAuto {
+		if len(s.Venues.List(req.GetChain())) == 0 {
+			return nil, status.Errorf(codes.NotFound, "no venue is configured on %s", req.GetChain())
+		}
+	} else if _, err := s.Venues.Swapper(ctx, req.GetChain(), req.GetDex()); err != nil {
+		return nil, err
+	}
+
+	if req.GetMaxPrice() != "" {
+		// The max price is compared in whole tokens, so both decimals must resolve.
+		for _, token := range []common.Address{tokenIn, tokenOut} {
+			if _, err := s.Tokens.Decimals(ctx, req.GetChain(), token); err != nil {
+				return nil, status.Errorf(codes.Unavailable, "failed to resolve decimals of %s: %v", token.Hex(), err)
+			}
+		}
+	}
+
+	plan, err := s.Planner.Create(scheduler.Plan{
+		Chain:       req.GetChain(),
+		Dex:         req.GetDex(),
+		TokenIn:     tokenIn.Hex(),
+		TokenOut:    tokenOut.Hex(),
+		AmountIn:    amountIn,
+		Schedule:    req.GetSchedule(),
+		SlippageBps: req.GetSlippageBps(),
+		MaxPrice:    req.GetMaxPrice(),
+		EndsAt:      endsAt,
+	})
+	if errors.Is(err, scheduler.ErrNoRun) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create the plan: %v", err)
+	}
+
+	return dcaPlan(plan), nil
+}
+
+// CancelDcaPlan stops an active plan, a run already sent is still settled.
+func (s *QuoteSwapServiceServer) CancelDcaPlan(ctx context.Context, req *quoteswap.DcaPlanRequest) (*quoteswap.DcaPlan, error) {
+	if s.Planner == nil {
+		return nil, status.Error(codes.Unimplemented, "DCA plans are not enabled")
+	}
+
+	plan, err := s.Planner.Cancel(req.GetId())
+	if err != nil {
+		return nil, planError(req.GetId(), err)
+	}
+
+	return dcaPlan(plan), nil
+}
+
+// GetDcaPlan returns a plan with every run made.
+func (s *QuoteSwapServiceServer) GetDcaPlan(ctx context.Context, req *quoteswap.DcaPlanRequest) (*quoteswap.DcaPlan, error) {
+	if s.Planner == nil {
+		return nil, status.Error(codes.Unimplemented, "DCA plans are not enabled")
+	}
+
+	plan, err := s.Planner.Get(req.GetId())
+	if err != nil {
+		return nil, planError(req.GetId(), err)
+	}
+
+	return dcaPlan(plan), nil
+}
+
+// ListDcaPlans lists the plans of a chain, or of every chain, oldest first.
+func (s *QuoteSwapServiceServer) ListDcaPlans(ctx context.Context, req *quoteswap.ListDcaPlansRequest) (*quoteswap.ListDcaPlansResponse, error) {
+	if s.Planner == nil {
+		return nil, status.Error(codes.Unimplemented, "DCA plans are not enabled")
+	}
+
+	resp := &quoteswap.ListDcaPlansResponse{}
+	for _, plan := range s.Planner.List(req.GetChain(), req.GetIncludeDone()) {
+		resp.Plans = append(resp.Plans, dcaPlan(plan))
+	}
+
+	return resp, nil
+}
+
+func planError(id string, err error) error {
+	switch {
+	case errors.Is(err, scheduler.ErrPlanNotFound):
+		return status.Errorf(codes.NotFound, "DCA plan %s not found", id)
+	case errors.Is(err, scheduler.ErrPlanDone):
+		return status.Errorf(codes.FailedPrecondition, "DCA plan %s is no longer active", id)
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func dcaPlan(plan *scheduler.Plan) *quoteswap.DcaPlan {
+	msg := &quoteswap.DcaPlan{
+		Id:          plan.ID,
+		Status:      orderStatuses[plan.Status],
+		Chain:       plan.Chain,
+		Dex:         plan.Dex,
+		TokenIn:     plan.TokenIn,
+		TokenOut:    plan.TokenOut,
+		AmountIn:    plan.AmountIn.String(),
+		Schedule:    plan.Schedule,
+		SlippageBps: plan.SlippageBps,
+		MaxPrice:    plan.MaxPrice,
+		CreatedAt:   uint64(plan.CreatedAt.Unix()),
+		Spent:       plan.Spent.String(),
+		Bought:      plan.Bought.String(),
+	}
+	if !plan.EndsAt.IsZero() {
+		msg.EndsAt = uint64(plan.EndsAt.Unix())
+	}
+	if !plan.Done() {
+		msg.NextRunAt = uint64(plan.NextAt.Unix())
+	}
+	if plan.Error != "" {
+		msg.Error = &quoteswap.Error{Code: int32(codes.Aborted), Message: plan.Error}
+	}
+
+	for _, run := range plan.Runs {
+		msg.Runs = append(msg.Runs, orderSlice(run))
+	}
+
+	return msg
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// maxDepthLevels caps the number of input sizes quoted by one GetDepth request.
+const maxDepthLevels = 50
+
+// defaultDepthBps is the ladder of input sizes, in basis points of the pool's reserve, quoted when
+// a GetDepth request gives none.
+var defaultDepthBps = []uint32{10, 25, 50, 100, 250, 500, 1000}
+
+// GetDepth quotes a ladder of input sizes on one venue at a single block, so traders can see how the
+// output and price impact scale with the order size. The levels are quoted as one batch.
+func (s *QuoteSwapServiceServer) GetDepth(ctx context.Context, req *quoteswap.GetDepthRequest) (*quoteswap.GetDepthResponse, error) {
+	if !common.IsHexAddress(req.GetTokenIn()) || !common.IsHexAddress(req.GetTokenOut()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token pair: %s, %s", req.GetTokenIn(), req.GetTokenOut())
+	}
+	tokenIn, tokenOut := common.HexToAddress(req.GetTokenIn()), common.HexToAddress(req.GetTokenOut())
+	if tokenIn == tokenOut {
+		return nil, status.Error(codes.InvalidArgument, "token_in and token_out must differ")
+	}
+	if len(req.GetDepthBps()) > maxDepthLevels || len(req.GetAmountsIn()) > maxDepthLevels {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d depth levels can be quoted at once", maxDepthLevels)
+	}
+
+	service, err := s.Venues.Swapper(ctx, req.GetChain(), req.GetDex())
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := s.resolveBlock(ctx, &quoteswap.GetQuoteRequest{Chain: req.GetChain()})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &quoteswap.GetDepthResponse{BlockNumber: block.Number}
+	var amounts []*big.Int
+
+	if len(req.GetAmountsIn()) > 0 {
+		for _, amountIn := range req.GetAmountsIn() {
+			amount, err := pancakeswap.ParseAmount(amountIn)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid amount in %q: %v", amountIn, err)
+			}
+			amounts = append(amounts, amount)
+			resp.Levels = append(resp.Levels, &quoteswap.DepthLevel{})
+		}
+	} else {
+		depthBps := req.GetDepthBps()
+		if len(depthBps) == 0 {
+			depthBps = defaultDepthBps
+		}
+
+		pool, reserveIn, err := s.deepestPool(ctx, service, req.GetChain(), tokenIn, tokenOut)
+		if err != nil {
+			return nil, err
+		}
+		resp.Pool, resp.ReserveIn = pool, reserveIn.String()
+
+		for _, bps := range depthBps {
+			if bps == 0 || bps > 10000 {
+				return nil, status.Errorf(codes.InvalidArgument, "depth of %d bps is not within 1-10000", bps)
+			}
+			amount := new(big.Int).Mul(reserveIn, big.NewInt(int64(bps)))
+			amounts = append(amounts, amount.Div(amount, big.NewInt(10000)))
+			resp.Levels = append(resp.Levels, &quoteswap.DepthLevel{DepthBps: bps})
+		}
+	}
+
+	var (
+		quotes []*quoteswap.GetQuoteRequest
+		levels []*quoteswap.DepthLevel
+	)
+	for i, amount := range amounts {
+		if amount.Sign() <= 0 {
+			resp.Levels[i].Error = &quoteswap.Error{Code: int32(codes.InvalidArgument), Message: "input size rounds to zero"}
+			continue
+		}
+
+		quotes = append(quotes, &quoteswap.GetQuoteRequest{
+			TokenIn:     tokenIn.Hex(),
+			TokenOut:    tokenOut.Hex(),
+			AmountIn:    amount.String(),
+			Dex:         req.GetDex(),
+			Chain:       req.GetChain(),
+			BlockNumber: block.Number,
+		})
+		levels = append(levels, resp.Levels[i])
+	}
+
+	batch, err := s.BatchGetQuote(ctx, &quoteswap.BatchGetQuoteRequest{Quotes: quotes})
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range batch.GetResults() {
+		levels[i].Quote, levels[i].Error = result.GetQuote(), result.GetError()
+	}
+
+	return resp, nil
+}
+
+// deepestPool returns the pool of the pair on the venue holding the largest reserve of tokenIn, with
+// that reserve.
+func (s *QuoteSwapServiceServer) deepestPool(ctx context.Context, service pancakeswap.Swapper, chain string, tokenIn, tokenOut common.Address) (*quoteswap.Pool, *big.Int, error) {
+	lister, ok := service.(pancakeswap.PoolLister)
+	if !ok {
+		return nil, nil, status.Error(codes.Unimplemented, "the venue cannot list its pools, pass amounts_in")
+	}
+
+	pools, err := lister.Pools(ctx, tokenIn, tokenOut)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		deepest   pancakeswap.Pool
+		reserveIn *big.Int
+	)
+	for _, pool := range pools {
+		if reserve := reserveOf(pool, tokenIn); reserve != nil && (reserveIn == nil || reserve.Cmp(reserveIn) > 0) {
+			deepest, reserveIn = pool, reserve
+		}
+	}
+	if reserveIn == nil || reserveIn.Sign() == 0 {
+		return nil, nil, status.Errorf(codes.NotFound, "no pool of %s/%s with liquidity on %s", tokenIn.Hex(), tokenOut.Hex(), chain)
+	}
+
+	decimalsIn, errIn := s.Tokens.Decimals(ctx, chain, tokenIn)
+	decimalsOut, errOut := s.Tokens.Decimals(ctx, chain, tokenOut)
+
+	return poolMessage(deepest, tokenIn, decimalsIn, decimalsOut, errIn == nil && errOut == nil), reserveIn, nil
+}
+
+// reserveOf returns the pool's reserve of token. A V3 pool has a virtual reserve at its current
+// price: L/sqrtP of token0 and L*sqrtP of token1.
+func reserveOf(pool pancakeswap.Pool, token common.Address) *big.Int {
+	switch {
+	case pool.Reserve0 != nil:
+		if token == pool.Token0 {
+			return pool.Reserve0
+		}
+		return pool.Reserve1
+	case pool.SqrtPriceX96 != nil && pool.SqrtPriceX96.Sign() > 0 && pool.Liquidity != nil:
+		if token == pool.Token0 {
+			reserve := new(big.Int).Lsh(pool.Liquidity, 96)
+			return reserve.Div(reserve, pool.SqrtPriceX96)
+		}
+		reserve := new(big.Int).Mul(pool.Liquidity, pool.SqrtPriceX96)
+		return reserve.Rsh(reserve, 96)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+)
+
+func TestReserveOf(t *testing.T) {
+	token0 := common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+	token1 := common.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+	q96 := new(big.Int).Lsh(big.NewInt(1), 96)
+	liquidity := big.NewInt(1e18)
+
+	tests := []struct {
+		name  string
+		pool  pancakeswap.Pool
+		token common.Address
+		want  *big.Int
+	}{
+		{"v2 token0", pancakeswap.Pool{Token0: token0, Token1: token1, Reserve0: big.NewInt(100), Reserve1: big.NewInt(300)}, token0, big.NewInt(100)},
+		{"v2 token1", pancakeswap.Pool{Token0: token0, Token1: token1, Reserve0: big.NewInt(100), Reserve1: big.NewInt(300)}, token1, big.NewInt(300)},
+		{"v3 at price 1, token0", pancakeswap.Pool{Token0: token0, Token1: token1, Liquidity: liquidity, SqrtPriceX96: q96}, token0, big.NewInt(1e18)},
+		{"v3 at price 1, token1", pancakeswap.Pool{Token0: token0, Token1: token1, Liquidity: liquidity, SqrtPriceX96: q96}, token1, big.NewInt(1e18)},
+		{"v3 at price 4, token0", pancakeswap.Pool{Token0: token0, Token1: token1, Liquidity: liquidity, SqrtPriceX96: new(big.Int).Lsh(q96, 1)}, token0, big.NewInt(5e17)},
+		{"v3 at price 4, token1", pancakeswap.Pool{Token0: token0, Token1: token1, Liquidity: liquidity, SqrtPriceX96: new(big.Int).Lsh(q96, 1)}, token1, big.NewInt(2e18)},
+		{"v3 without a price", pancakeswap.Pool{Token0: token0, Token1: token1, Liquidity: liquidity, SqrtPriceX96: new(big.Int)}, token0, nil},
+		{"no state", pancakeswap.Pool{Token0: token0, Token1: token1}, token0, nil},
+	}
+
+	for _, tt := range tests {
+		got := reserveOf(tt.pool, tt.token)
+		if (got == nil) != (tt.want == nil) || (got != nil && got.Cmp(tt.want) != 0) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGetDepthValidation(t *testing.T) {
+	const (
+		tokenIn  = "0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c"
+		tokenOut = "0x55d398326f99059fF775485246999027B3197955"
+	)
+	levels := make([]uint32, maxDepthLevels+1)
+
+	tests := []struct {
+		name string
+		req  *quoteswap.GetDepthRequest
+	}{
+		{"invalid token", &quoteswap.GetDepthRequest{TokenIn: "0x1234", TokenOut: tokenOut}},
+		{"same token", &quoteswap.GetDepthRequest{TokenIn: tokenIn, TokenOut: tokenIn}},
+		{"too many depths", &quoteswap.GetDepthRequest{TokenIn: tokenIn, TokenOut: tokenOut, DepthBps: levels}},
+		{"too many amounts", &quoteswap.GetDepthRequest{TokenIn: tokenIn, TokenOut: tokenOut, AmountsIn: make([]string, maxDepthLevels+1)}},
+	}
+
+	s := &QuoteSwapServiceServer{}
+	for _, tt := range tests {
+		_, err := s.GetDepth(context.Background(), tt.req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got %v, want InvalidArgument", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/token"
+)
+
+// receiptTimeout bounds how long ExecuteSwap waits for a swap to be mined when asked to.
+const receiptTimeout = 3 * time.Minute
+
+// AwaitFill waits for the swap in resp to be mined and replaces the pre-execution values
+// of resp with the amounts, price and gas reported by the receipt.
+func (s *QuoteSwapServiceServer) AwaitFill(ctx context.Context, quote *quoteswap.GetQuoteResponse, resp *quoteswap.ExecuteTxResponse) error {
+	client, ok := s.Clients[quote.GetChain()]
+	if !ok {
+		return fmt.Errorf("no client found for chain: %s", quote.GetChain())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, receiptTimeout)
+	defer cancel()
+
+	hash := common.HexToHash(resp.GetTransactionHash())
+
+	receipt, err := bind.WaitMined(ctx, client.Eth(), hash)
+	if err != nil {
+		return fmt.Errorf("waiting for %s to be mined: %w", hash.Hex(), err)
+	}
+
+	tx, _, err := client.Eth().TransactionByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return err
+	}
+
+	tokenIn := common.HexToAddress(quote.GetInputToken())
+	tokenOut := common.HexToAddress(quote.GetOutputToken())
+	recipient := common.HexToAddress(os.Getenv("RECIPIENT_ADDR"))
+
+	fill := pancakeswap.ParseFill(receipt, tokenIn, tokenOut, sender, recipient)
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		resp.Status = quoteswap.TransactionStatus_FAILED
+		resp.Error = &quoteswap.Error{Code: 5, Message: fmt.Sprintf("swap transaction %s reverted", hash.Hex())}
+	} else {
+		resp.Status = quoteswap.TransactionStatus_SUCCESS
+	}
+
+	resp.Fill = &quoteswap.Fill{
+		AmountIn:            fill.AmountIn.String(),
+		AmountOut:           fill.AmountOut.String(),
+		RealizedSlippageBps: realizedSlippageBps(quote.GetOutAmount(), fill.AmountOut),
+		GasUsed:             fill.GasUsed,
+		GasPaid:             fill.GasPaid.String(),
+		BlockNumber:         fill.BlockNumber,
+	}
+	for _, pool := range fill.Pools {
+		resp.Fill.Pools = append(resp.Fill.Pools, pool.Hex())
+	}
+
+	if fill.AmountIn.Sign() == 0 {
+		return nil
+	}
+
+	resp.SellTokenQty = pancakeswap.Float64(fill.AmountIn)
+	resp.SellTokenAmount = fill.AmountIn.String()
+
+	decimalsIn, err := s.Tokens.Decimals(ctx, quote.GetChain(), tokenIn)
+	if err != nil {
+		logrus.Warnf("failed to price fill of %s: %v", hash.Hex(), err)
+		return nil
+	}
+	decimalsOut, err := s.Tokens.Decimals(ctx, quote.GetChain(), tokenOut)
+	if err != nil {
+		logrus.Warnf("failed to price fill of %s: %v", hash.Hex(), err)
+		return nil
+	}
+
+	price := token.Price(fill.AmountIn, decimalsIn, fill.AmountOut, decimalsOut)
+
+	resp.ExecutedPrice, _ = price.Float64()
+	resp.ExecutedPriceDecimal = token.FormatPrice(price)
+	resp.Fill.EffectivePrice = resp.ExecutedPriceDecimal
+	resp.Fill.AmountInDecimal = token.FormatUnits(fill.AmountIn, decimalsIn)
+	resp.Fill.AmountOutDecimal = token.FormatUnits(fill.AmountOut, decimalsOut)
+
+	return nil
+}
+
+// realizedSlippageBps compares the actual output with the quoted one, in basis points of the quote.
+func realizedSlippageBps(quotedOut string, amountOut *big.Int) int64 {
+	quoted, ok := new(big.Int).SetString(quotedOut, 10)
+	if !ok || quoted.Sign() == 0 {
+		return 0
+	}
+
+	diff := new(big.Int).Sub(quoted, amountOut)
+	diff.Mul(diff, big.NewInt(10000))
+
+	return diff.Quo(diff, quoted).Int64()
+}
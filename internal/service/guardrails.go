@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/registry"
+	"grpc_cake/internal/token"
+)
+
+// checkGuardrails enforces the chain's guardrails on a swap of quote before it is signed. The swap
+// is quoted again rather than trusting the client's quote, and the quote must swap through the
+// fresh quote's pools. A limit that cannot be evaluated rejects the swap like a violated one,
+// except the deviation of a pair no other venue quotes.
+func (s *QuoteSwapServiceServer) checkGuardrails(ctx context.Context, quote *quoteswap.GetQuoteResponse) error {
+	client, ok := s.Clients[quote.GetChain()]
+	if !ok {
+		return nil
+	}
+
+	guardrails := client.Config.Guardrails
+	tokenIn, tokenOut := common.HexToAddress(quote.GetInputToken()), common.HexToAddress(quote.GetOutputToken())
+	limits := guardrails.For(tokenIn, tokenOut)
+	if limits == (registry.Limits{}) {
+		return nil
+	}
+
+	fresh, err := s.GetQuote(ctx, &quoteswap.GetQuoteRequest{
+		TokenIn:  quote.GetInputToken(),
+		TokenOut: quote.GetOutputToken(),
+		AmountIn: quote.GetInAmount(),
+		Dex:      quote.GetDex(),
+		Chain:    quote.GetChain(),
+	})
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "guardrails: failed to quote the swap: %v", err)
+	}
+	// The limits are checked on the fresh quote's pool, the swap must not go through another one.
+	if pool := quote.GetPool(); pool != nil && fresh.GetPool() != nil && pool.GetFee() != fresh.GetPool().GetFee() {
+		return status.Errorf(codes.FailedPrecondition, "guardrails: the swap is quoted through the %d fee pool but the %d fee pool quotes it now", pool.GetFee(), fresh.GetPool().GetFee())
+	}
+	if len(quote.GetSplits()) > 0 {
+		if err := checkSplit(quote, fresh); err != nil {
+			return err
+		}
+	}
+	amountIn, _ := new(big.Int).SetString(fresh.GetInAmount(), 10)
+	amountOut, _ := new(big.Int).SetString(fresh.GetOutAmount(), 10)
+
+	if limits.MaxPriceImpactBps > 0 {
+		impact, err := strconv.ParseFloat(fresh.GetPriceImpactBps(), 64)
+		if err != nil {
+			return status.Error(codes.FailedPrecondition, "guardrails: the price impact of the swap is unknown")
+		}
+		if impact > limits.MaxPriceImpactBps {
+			return status.Errorf(codes.FailedPrecondition, "guardrails: price impact of %.2f bps exceeds %.2f bps", impact, limits.MaxPriceImpactBps)
+		}
+	}
+
+	if limits.MaxDeviationBps > 0 {
+		reference := s.bestQuote(ctx, quote.GetChain(), quote.GetDex(), tokenIn, tokenOut, fresh.GetInAmount())
+
+		// A pair quoted by a single venue has no reference price, the check is skipped for it.
+		if reference != nil {
+			// Both quotes sell the same amount, so their outputs compare like their prices.
+			if deviation := shortfallBps(new(big.Rat).SetInt(amountOut), new(big.Rat).SetInt(reference)); deviation > limits.MaxDeviationBps {
+				return status.Errorf(codes.FailedPrecondition, "guardrails: execution price is %.2f bps worse than the reference price, limit %.2f bps", deviation, limits.MaxDeviationBps)
+			}
+		}
+	}
+
+	if limits.MaxTwapDeviationBps > 0 {
+		dex, fee := quote.GetDex(), fresh.GetPool().GetFee()
+		if splits := fresh.GetSplits(); len(splits) > 0 {
+			// A split is compared with the TWAP of the pool of its largest leg.
+			dex, fee = splits[0].GetPool().GetDex(), splits[0].GetPool().GetFee()
+		}
+
+		twap, err := s.twap(ctx, quote.GetChain(), dex, tokenIn, tokenOut, fee, guardrails.TwapWindowSeconds)
+		if err != nil {
+			return status.Errorf(codes.FailedPrecondition, "guardrails: failed to read the TWAP reference price: %v", err)
+		}
+
+		twapIn, twapOut := twapAmounts(twap, tokenIn)
+		if twapIn.Sign() <= 0 || twapOut.Sign() <= 0 {
+			return status.Error(codes.FailedPrecondition, "guardrails: the TWAP reference price is zero")
+		}
+		execution, reference := new(big.Rat).SetFrac(amountOut, amountIn), new(big.Rat).SetFrac(twapOut, twapIn)
+		if deviation := shortfallBps(execution, reference); deviation > limits.MaxTwapDeviationBps {
+			return status.Errorf(codes.FailedPrecondition, "guardrails: execution price is %.2f bps worse than the %ds TWAP, limit %.2f bps", deviation, twap.Window, limits.MaxTwapDeviationBps)
+		}
+	}
+
+	if limits.MaxNotional > 0 {
+		notional, err := s.notional(ctx, quote.GetChain(), guardrails.NotionalToken, tokenIn, tokenOut, amountIn, amountOut)
+		if err != nil {
+			return status.Errorf(codes.FailedPrecondition, "guardrails: %v", err)
+		}
+		if notional > limits.MaxNotional {
+			return status.Errorf(codes.FailedPrecondition, "guardrails: notional of %.2f exceeds %.2f", notional, limits.MaxNotional)
+		}
+	}
+
+	return nil
+}
+
+// checkSplit checks that the legs of a split quote, which ExecuteSwap sends, are those of the fresh
+// split the limits are checked on: the same pools selling the same amounts, each leg accepting no
+// less than the fresh leg's output less the quote's slippage_bps.
+func checkSplit(quote, fresh *quoteswap.GetQuoteResponse) error {
+	if len(quote.GetSplits()) != len(fresh.GetSplits()) {
+		return status.Errorf(codes.FailedPrecondition, "guardrails: the swap is split into %d legs but the fresh quote into %d", len(quote.GetSplits()), len(fresh.GetSplits()))
+	}
+
+	freshLegs := make(map[string]*quoteswap.SplitLeg, len(fresh.GetSplits()))
+	for _, leg := range fresh.GetSplits() {
+		freshLegs[legKey(leg.GetPool())] = leg
+	}
+
+	slippageBps := big.NewInt(int64(10000 - min(max(quote.GetSlippageBps(), 0), 10000)))
+	for _, leg := range quote.GetSplits() {
+		pool := leg.GetPool()
+		freshLeg, ok := freshLegs[legKey(pool)]
+		if !ok {
+			return status.Errorf(codes.FailedPrecondition, "guardrails: the fresh split has no leg through the %s pool %s", pool.GetDex(), pool.GetAddress())
+		}
+
+		in, _ := new(big.Int).SetString(leg.GetInAmount(), 10)
+		freshIn, _ := new(big.Int).SetString(freshLeg.GetInAmount(), 10)
+		if in == nil || freshIn == nil || in.Cmp(freshIn) != 0 {
+			return status.Errorf(codes.FailedPrecondition, "guardrails: the leg through the %s pool %s sells %s but the fresh split %s", pool.GetDex(), pool.GetAddress(), leg.GetInAmount(), freshLeg.GetInAmount())
+		}
+
+		minOut, _ := new(big.Int).SetString(leg.GetOutAmount(), 10)
+		floor, _ := new(big.Int).SetString(freshLeg.GetOutAmount(), 10)
+		if floor == nil {
+			return status.Errorf(codes.FailedPrecondition, "guardrails: the fresh split has no output for the %s pool %s", pool.GetDex(), pool.GetAddress())
+		}
+		floor.Mul(floor, slippageBps).Div(floor, big.NewInt(10000))
+		if minOut == nil || minOut.Cmp(floor) < 0 {
+			return status.Errorf(codes.FailedPrecondition, "guardrails: the leg through the %s pool %s accepts %s, below %s at %d bps of slippage", pool.GetDex(), pool.GetAddress(), leg.GetOutAmount(), floor, quote.GetSlippageBps())
+		}
+	}
+
+	return nil
+}
+
+// legKey identifies the pool of a split leg.
+func legKey(pool *quoteswap.Pool) string {
+	return fmt.Sprintf("%s/%s/%d", pool.GetDex(), strings.ToLower(pool.GetAddress()), pool.GetFee())
+}
+
+// bestQuote returns the highest output of the chain's venues other than excludeDex for amountIn,
+// nil when none quotes. The smart router is left out, its splits go through the same pools.
+func (s *QuoteSwapServiceServer) bestQuote(ctx context.Context, chain, excludeDex string, tokenIn, tokenOut common.Address, amountIn string) *big.Int {
+	var quotes []*quoteswap.GetQuoteRequest
+	for _, venue := range s.Venues.List(chain) {
+		if venue.GetDex() == excludeDex || venue.GetDex() == registry.DexSmart || !venue.GetReady() {
+			continue
+		}
+		quotes = append(quotes, &quoteswap.GetQuoteRequest{
+			TokenIn:  tokenIn.Hex(),
+			TokenOut: tokenOut.Hex(),
+			AmountIn: amountIn,
+			Dex:      venue.GetDex(),
+			Chain:    chain,
+		})
+	}
+	if len(quotes) == 0 {
+		return nil
+	}
+
+	batch, err := s.BatchGetQuote(ctx, &quoteswap.BatchGetQuoteRequest{Quotes: quotes})
+	if err != nil {
+		return nil
+	}
+
+	var best *big.Int
+	for _, result := range batch.GetResults() {
+		out, ok := new(big.Int).SetString(result.GetQuote().GetOutAmount(), 10)
+		if ok && out.Sign() > 0 && (best == nil || out.Cmp(best) > 0) {
+			best = out
+		}
+	}
+
+	return best
+}
+
+// notional values a swap in whole notional tokens, from its own amounts when it trades the
+// notional token and from the best quote of the sold amount otherwise.
+func (s *QuoteSwapServiceServer) notional(ctx context.Context, chain string, notionalToken, tokenIn, tokenOut common.Address, amountIn, amountOut *big.Int) (float64, error) {
+	var value *big.Int
+	switch notionalToken {
+	case tokenIn:
+		value = amountIn
+	case tokenOut:
+		value = amountOut
+	default:
+		if value = s.bestQuote(ctx, chain, "", tokenIn, notionalToken, amountIn.String()); value == nil {
+			return 0, fmt.Errorf("no venue values %s in %s", tokenIn.Hex(), notionalToken.Hex())
+		}
+	}
+
+	decimals, err := s.Tokens.Decimals(ctx, chain, notionalToken)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(token.FormatUnits(value, decimals), 64)
+}
+
+// shortfallBps is how much lower value is than reference in basis points, zero when it is not lower.
+func shortfallBps(value, reference *big.Rat) float64 {
+	if reference.Sign() <= 0 {
+		return 0
+	}
+
+	shortfall := new(big.Rat).Sub(reference, value)
+	shortfall.Quo(shortfall, reference)
+	bps, _ := shortfall.Mul(shortfall, big.NewRat(10000, 1)).Float64()
+
+	return max(0, bps)
+}
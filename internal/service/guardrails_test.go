@@ -0,0 +1,80 @@
+package service
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"grpc_cake/gen/go/quoteswap"
+)
+
+func TestShortfallBps(t *testing.T) {
+	tests := []struct {
+		name             string
+		value, reference *big.Rat
+		want             float64
+	}{
+		{"equal", big.NewRat(100, 1), big.NewRat(100, 1), 0},
+		{"one percent lower", big.NewRat(99, 1), big.NewRat(100, 1), 100},
+		{"fraction of a bp", big.NewRat(999995, 1), big.NewRat(1000000, 1), 0.05},
+		{"better than the reference", big.NewRat(101, 1), big.NewRat(100, 1), 0},
+		{"prices as fractions", big.NewRat(2985, 10), big.NewRat(300, 1), 50},
+		{"nothing", new(big.Rat), big.NewRat(100, 1), 10000},
+		{"no reference", big.NewRat(100, 1), new(big.Rat), 0},
+		{"negative reference", big.NewRat(100, 1), big.NewRat(-1, 1), 0},
+	}
+
+	for _, tt := range tests {
+		if got := shortfallBps(tt.value, tt.reference); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCheckSplit(t *testing.T) {
+	const (
+		v2Pair = "0x16b9a82891338f9bA80E2D6970FddA79D1eb0daE"
+		v3Pool = "0x36696169C63e42cd08ce11f5deeBbCeBae652050"
+	)
+	leg := func(dex, address string, fee uint32, in, out string) *quoteswap.SplitLeg {
+		return &quoteswap.SplitLeg{Pool: &quoteswap.Pool{Dex: dex, Address: address, Fee: fee}, InAmount: in, OutAmount: out}
+	}
+	fresh := &quoteswap.GetQuoteResponse{Splits: []*quoteswap.SplitLeg{
+		leg("v3", v3Pool, 500, "700", "20000"),
+		leg("v2", v2Pair, 2500, "300", "10000"),
+	}}
+	split := func(slippageBps int32, legs ...*quoteswap.SplitLeg) *quoteswap.GetQuoteResponse {
+		return &quoteswap.GetQuoteResponse{SlippageBps: slippageBps, Splits: legs}
+	}
+
+	tests := []struct {
+		name    string
+		quote   *quoteswap.GetQuoteResponse
+		wantErr string
+	}{
+		{"same legs", split(0, leg("v3", v3Pool, 500, "700", "20000"), leg("v2", v2Pair, 2500, "300", "10000")), ""},
+		{"other order and address case", split(0, leg("v2", strings.ToLower(v2Pair), 2500, "300", "10000"), leg("v3", v3Pool, 500, "700", "20000")), ""},
+		{"minimums within the slippage", split(50, leg("v3", v3Pool, 500, "700", "19900"), leg("v2", v2Pair, 2500, "300", "9950")), ""},
+		{"minimum below the slippage", split(50, leg("v3", v3Pool, 500, "700", "19899"), leg("v2", v2Pair, 2500, "300", "9950")), "accepts 19899, below 19900"},
+		{"minimum below the quote without slippage", split(0, leg("v3", v3Pool, 500, "700", "20000"), leg("v2", v2Pair, 2500, "300", "9999")), "accepts 9999"},
+		{"no minimum", split(0, leg("v3", v3Pool, 500, "700", ""), leg("v2", v2Pair, 2500, "300", "10000")), "accepts"},
+		{"another fee tier", split(0, leg("v3", v3Pool, 100, "700", "20000"), leg("v2", v2Pair, 2500, "300", "10000")), "no leg through the v3 pool"},
+		{"another pool", split(0, leg("v3", v2Pair, 500, "700", "20000"), leg("v2", v2Pair, 2500, "300", "10000")), "no leg through the v3 pool"},
+		{"another amount in", split(0, leg("v3", v3Pool, 500, "800", "20000"), leg("v2", v2Pair, 2500, "200", "10000")), "sells 800 but the fresh split 700"},
+		{"fewer legs", split(0, leg("v3", v3Pool, 500, "1000", "29000")), "split into 1 legs but the fresh quote into 2"},
+	}
+
+	for _, tt := range tests {
+		err := checkSplit(tt.quote, fresh)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
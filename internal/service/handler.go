@@ -2,56 +2,81 @@ package service
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
 	"grpc_cake/gen/go/quoteswap"
-	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/scheduler"
+	"grpc_cake/internal/token"
 )
 
 type QuoteSwapServiceServer struct {
 	quoteswap.UnimplementedQuoteSwapServiceServer
-	// using this map to save chain as key and Swapper implementation as value
-	V2Services map[string]pancakeswap.Swapper
-	V3Services map[string]pancakeswap.Swapper
+	// Venues holds the Swapper implementation of every configured (chain, dex) pair.
+	Venues  *Venues
+	Clients map[string]*blockchain.Client
+	Tokens  *token.Registry
+	// Quotes caches quotes per block, quotes are not cached when it is nil.
+	Quotes *QuoteCache
+	// Wallets are the addresses reported by GetBalances.
+	Wallets []common.Address
+	// Scheduler works scheduled orders, the scheduler RPCs are unimplemented when it is nil.
+	Scheduler *scheduler.Scheduler
+	// Limits monitors limit orders, the limit order RPCs are unimplemented when it is nil.
+	Limits *scheduler.LimitBook
+	// Triggers evaluates stop-loss and take-profit triggers, the trigger RPCs are unimplemented when it is nil.
+	Triggers *scheduler.TriggerBook
+	// Planner runs DCA plans, the DCA RPCs are unimplemented when it is nil.
+	Planner *scheduler.Planner
 }
 
 func (s *QuoteSwapServiceServer) GetQuote(ctx context.Context, req *quoteswap.GetQuoteRequest) (*quoteswap.GetQuoteResponse, error) {
-	var service pancakeswap.Swapper
-	chain := req.GetChain()
-
-	switch req.GetDex() {
-	case "v2":
-		service = s.V2Services[chain]
-	case "v3":
-		service = s.V3Services[chain]
-	default:
-		return nil, errors.New(fmt.Sprintf("unsupported dex version: %s", req.GetDex()))
+	service, err := s.Venues.Swapper(ctx, req.GetChain(), req.GetDex())
+	if err != nil {
+		return nil, err
 	}
 
-	if service == nil {
-		return nil, errors.New(fmt.Sprintf("no service found for chain: %s", chain))
+	req, block, err := s.normalizeQuoteRequest(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	return service.GetQuote(ctx, req)
+	resp := s.Quotes.Get(req, block)
+	if resp == nil {
+		resp, err = service.GetQuote(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		setBlock(resp, block)
+		s.priceQuote(ctx, service, req, resp)
+		s.Quotes.Put(req, block, resp)
+	}
+	s.decorateQuote(ctx, resp)
+
+	return resp, nil
 }
 
 func (s *QuoteSwapServiceServer) ExecuteSwap(ctx context.Context, req *quoteswap.ExecuteTxRequest) (*quoteswap.ExecuteTxResponse, error) {
-	var service pancakeswap.Swapper
-	chain := req.QuotingResponse.GetChain()
-
-	switch req.QuotingResponse.GetDex() {
-	case "v2":
-		service = s.V2Services[chain]
-	case "v3":
-		service = s.V3Services[chain]
-	default:
-		return nil, errors.New(fmt.Sprintf("unsupported dex version: %s", req.QuotingResponse.GetDex()))
+	service, err := s.Venues.Swapper(ctx, req.QuotingResponse.GetChain(), req.QuotingResponse.GetDex())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkGuardrails(ctx, req.GetQuotingResponse()); err != nil {
+		logrus.Warnf("Rejected swap on %s %s: %v", req.GetQuotingResponse().GetChain(), req.GetQuotingResponse().GetDex(), err)
+		return nil, err
+	}
+
+	resp, err := service.ExecuteSwap(ctx, req)
+	if err != nil || !req.GetWaitForReceipt() || resp.GetStatus() != quoteswap.TransactionStatus_PENDING {
+		return resp, err
 	}
 
-	if service == nil {
-		return nil, errors.New(fmt.Sprintf("no service found for chain: %s", chain))
+	if err := s.AwaitFill(ctx, req.GetQuotingResponse(), resp); err != nil {
+		logrus.Warnf("failed to read fill of %s: %v", resp.GetTransactionHash(), err)
+		resp.Error = &quoteswap.Error{Code: 5, Message: err.Error()}
 	}
 
-	return service.ExecuteSwap(ctx, req)
+	return resp, nil
 }
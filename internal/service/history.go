@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/token"
+)
+
+// maxHistoryPoints caps the number of blocks quoted by one GetHistoricalQuotes request.
+const maxHistoryPoints = 100000
+
+func (s *QuoteSwapServiceServer) GetHistoricalQuotes(req *quoteswap.GetHistoricalQuotesRequest, stream grpc.ServerStreamingServer[quoteswap.HistoricalQuote]) error {
+	return s.HistoricalQuotes(stream.Context(), req, stream.Send)
+}
+
+// HistoricalQuotes evaluates req.quote at every step-th block of the range and hands each result
+// to emit in block order. Quoting past blocks needs an archive node, or a node keeping their state.
+// A block the quote fails at is reported in its result, the backfill goes on.
+func (s *QuoteSwapServiceServer) HistoricalQuotes(ctx context.Context, req *quoteswap.GetHistoricalQuotesRequest, emit func(*quoteswap.HistoricalQuote) error) error {
+	from, to, step := req.GetFromBlock(), req.GetToBlock(), req.GetStep()
+	if to == 0 {
+		to = from
+	}
+	if step == 0 {
+		step = 1
+	}
+	if from == 0 || to < from {
+		return status.Errorf(codes.InvalidArgument, "invalid block range %d-%d", from, to)
+	}
+	if (to-from)/step >= maxHistoryPoints {
+		return status.Errorf(codes.InvalidArgument, "block range %d-%d with step %d exceeds %d quotes", from, to, step, maxHistoryPoints)
+	}
+
+	quote := proto.Clone(req.GetQuote()).(*quoteswap.GetQuoteRequest)
+	quote.BlockHash = ""
+	quote.MaxStalenessBlocks = 0
+
+	decimalsIn, errIn := s.Tokens.Decimals(ctx, quote.GetChain(), common.HexToAddress(quote.GetTokenIn()))
+	decimalsOut, errOut := s.Tokens.Decimals(ctx, quote.GetChain(), common.HexToAddress(quote.GetTokenOut()))
+	withPrice := errIn == nil && errOut == nil
+
+	for block := from; block <= to; block += step {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		quote.BlockNumber = block
+		point := &quoteswap.HistoricalQuote{BlockNumber: block}
+
+		resp, err := s.GetQuote(ctx, quote)
+		if err != nil {
+			point.Error = &quoteswap.Error{Code: int32(status.Code(err)), Message: err.Error()}
+		} else {
+			point.BlockTimestamp = resp.GetBlockTimestamp()
+			point.InAmount = resp.GetInAmount()
+			point.OutAmount = resp.GetOutAmount()
+			point.OutAmountDecimal = resp.GetOutAmountDecimal()
+
+			amountIn, okIn := new(big.Int).SetString(resp.GetInAmount(), 10)
+			amountOut, okOut := new(big.Int).SetString(resp.GetOutAmount(), 10)
+			if withPrice && okIn && okOut && amountIn.Sign() > 0 {
+				point.Price = token.FormatPrice(token.Price(amountIn, decimalsIn, amountOut, decimalsOut))
+			}
+		}
+
+		if err := emit(point); err != nil {
+			return err
+		}
+
+		// Guard against wrapping around at the top of the uint64 range.
+		if to-block < step {
+			break
+		}
+	}
+
+	return nil
+}
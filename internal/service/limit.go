@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/scheduler"
+)
+
+// PlaceLimitOrder stores an order to sell amount_in once it gets at least limit_price, checked
+// at every new head of the chain until expires_at.
+func (s *QuoteSwapServiceServer) PlaceLimitOrder(ctx context.Context, req *quoteswap.PlaceLimitOrderRequest) (*quoteswap.LimitOrder, error) {
+	if s.Limits == nil {
+		return nil, status.Error(codes.Unimplemented, "limit orders are not enabled")
+	}
+
+	if !common.IsHexAddress(req.GetTokenIn()) || !common.IsHexAddress(req.GetTokenOut()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token pair: %s, %s", req.GetTokenIn(), req.GetTokenOut())
+	}
+	tokenIn, tokenOut := common.HexToAddress(req.GetTokenIn()), common.HexToAddress(req.GetTokenOut())
+	if tokenIn == tokenOut {
+		return nil, status.Error(codes.InvalidArgument, "token_in and token_out must differ")
+	}
+
+	amountIn, err := pancakeswap.ParseAmount(req.GetAmountIn())
+	if err != nil || amountIn.Sign() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount in: %q", req.GetAmountIn())
+	}
+	price, ok := new(big.Rat).SetString(req.GetLimitPrice())
+	if !ok || price.Sign() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid limit price: %q", req.GetLimitPrice())
+	}
+	expiresAt := time.Unix(int64(req.GetExpiresAt()), 0)
+	if req.GetExpiresAt() == 0 || !expiresAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+	}
+	if req.GetSlippageBps() >= 10000 {
+		return nil, status.Error(codes.InvalidArgument, "slippage_bps must be below 10000")
+	}
+
+	if _, err := s.Venues.Swapper(ctx, req.GetChain(), req.GetDex()); err != nil {
+		return nil, err
+	}
+	if s.Clients[req.GetChain()] == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "chain %s has no client to monitor heads", req.GetChain())
+	}
+
+	decimalsIn, err := s.Tokens.Decimals(ctx, req.GetChain(), tokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to resolve decimals of %s: %v", tokenIn.Hex(), err)
+	}
+	decimalsOut, err := s.Tokens.Decimals(ctx, req.GetChain(), tokenOut)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to resolve decimals of %s: %v", tokenOut.Hex(), err)
+	}
+
+	order, err := s.Limits.Place(scheduler.LimitOrder{
+		Chain:        req.GetChain(),
+		Dex:          req.GetDex(),
+		TokenIn:      tokenIn.Hex(),
+		TokenOut:     tokenOut.Hex(),
+		AmountIn:     amountIn,
+		LimitPrice:   req.GetLimitPrice(),
+		MinAmountOut: minAmountOut(amountIn, price, decimalsIn, decimalsOut),
+		SlippageBps:  req.GetSlippageBps(),
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to place the order: %v", err)
+	}
+
+	return limitOrder(order), nil
+}
+
+// CancelLimitOrder stops monitoring an active limit order, a swap already sent is still settled.
+func (s *QuoteSwapServiceServer) CancelLimitOrder(ctx context.Context, req *quoteswap.LimitOrderRequest) (*quoteswap.LimitOrder, error) {
+	if s.Limits == nil {
+		return nil, status.Error(codes.Unimplemented, "limit orders are not enabled")
+	}
+
+	order, err := s.Limits.Cancel(req.GetId())
+	if err != nil {
+		return nil, limitError(req.GetId(), err)
+	}
+
+	return limitOrder(order), nil
+}
+
+// GetLimitOrder returns a limit order with its last check and the swaps sent for it.
+func (s *QuoteSwapServiceServer) GetLimitOrder(ctx context.Context, req *quoteswap.LimitOrderRequest) (*quoteswap.LimitOrder, error) {
+	if s.Limits == nil {
+		return nil, status.Error(codes.Unimplemented, "limit orders are not enabled")
+	}
+
+	order, err := s.Limits.Get(req.GetId())
+	if err != nil {
+		return nil, limitError(req.GetId(), err)
+	}
+
+	return limitOrder(order), nil
+}
+
+// minAmountOut applies a price in whole tokens to amountIn, rounding up so a fill at the minimum
+// never prices below the limit.
+func minAmountOut(amountIn *big.Int, price *big.Rat, decimalsIn, decimalsOut uint8) *big.Int {
+	out := new(big.Rat).Mul(new(big.Rat).SetInt(amountIn), price)
+	out.Mul(out, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimalsOut)), nil)))
+	out.Quo(out, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimalsIn)), nil)))
+
+	ceil := new(big.Int).Add(out.Num(), out.Denom())
+	ceil.Sub(ceil, big.NewInt(1))
+
+	return ceil.Div(ceil, out.Denom())
+}
+
+func limitError(id string, err error) error {
+	switch {
+	case errors.Is(err, scheduler.ErrLimitNotFound):
+		return status.Errorf(codes.NotFound, "limit order %s not found", id)
+	case errors.Is(err, scheduler.ErrLimitDone):
+		return status.Errorf(codes.FailedPrecondition, "limit order %s is no longer active", id)
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func limitOrder(order *scheduler.LimitOrder) *quoteswap.LimitOrder {
+	msg := &quoteswap.LimitOrder{
+		Id:            order.ID,
+		Status:        orderStatuses[order.Status],
+		Chain:         order.Chain,
+		Dex:           order.Dex,
+		TokenIn:       order.TokenIn,
+		TokenOut:      order.TokenOut,
+		AmountIn:      order.AmountIn.String(),
+		LimitPrice:    order.LimitPrice,
+		MinAmountOut:  order.MinAmountOut.String(),
+		SlippageBps:   order.SlippageBps,
+		CreatedAt:     uint64(order.CreatedAt.Unix()),
+		ExpiresAt:     uint64(order.ExpiresAt.Unix()),
+		CheckedBlock:  order.CheckedBlock,
+		LastQuotedOut: amountString(order.LastOut),
+	}
+	if order.Error != "" {
+		msg.Error = &quoteswap.Error{Code: int32(codes.Aborted), Message: order.Error}
+	}
+
+	for _, execution := range order.Executions {
+		msg.Executions = append(msg.Executions, orderSlice(execution))
+	}
+
+	return msg
+}
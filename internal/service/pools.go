@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/token"
+)
+
+// q192 is 2^192, the scale of a squared V3 sqrtPriceX96.
+var q192 = new(big.Int).Lsh(big.NewInt(1), 192)
+
+// ListPools returns every existing pool of a token pair on the chain, from the factories of all
+// ready venues. A venue failing to list its pools is skipped unless every venue fails.
+func (s *QuoteSwapServiceServer) ListPools(ctx context.Context, req *quoteswap.ListPoolsRequest) (*quoteswap.ListPoolsResponse, error) {
+	if !common.IsHexAddress(req.GetTokenA()) || !common.IsHexAddress(req.GetTokenB()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token pair: %s, %s", req.GetTokenA(), req.GetTokenB())
+	}
+	tokenA, tokenB := common.HexToAddress(req.GetTokenA()), common.HexToAddress(req.GetTokenB())
+	if tokenA == tokenB {
+		return nil, status.Error(codes.InvalidArgument, "token_a and token_b must differ")
+	}
+
+	venues := s.Venues.List(req.GetChain())
+	if req.GetChain() == "" || len(venues) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no venue found for chain: %s", req.GetChain())
+	}
+
+	var (
+		pools   []pancakeswap.Pool
+		lastErr error
+		listed  bool
+	)
+	for _, venue := range venues {
+		service, err := s.Venues.Swapper(ctx, venue.GetChain(), venue.GetDex())
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		lister, ok := service.(pancakeswap.PoolLister)
+		if !ok {
+			continue
+		}
+
+		found, err := lister.Pools(ctx, tokenA, tokenB)
+		if err != nil {
+			logrus.Warnf("failed to list %s pools on %s: %v", venue.GetDex(), venue.GetChain(), err)
+			lastErr = err
+			continue
+		}
+		pools = append(pools, found...)
+		listed = true
+	}
+	if !listed && lastErr != nil {
+		return nil, lastErr
+	}
+
+	decimalsA, errA := s.Tokens.Decimals(ctx, req.GetChain(), tokenA)
+	decimalsB, errB := s.Tokens.Decimals(ctx, req.GetChain(), tokenB)
+	withPrice := errA == nil && errB == nil
+
+	resp := &quoteswap.ListPoolsResponse{}
+	for _, pool := range pools {
+		resp.Pools = append(resp.Pools, poolMessage(pool, tokenA, decimalsA, decimalsB, withPrice))
+	}
+
+	return resp, nil
+}
+
+// poolMessage converts pool, priced in tokenB per tokenA when withPrice is set.
+func poolMessage(pool pancakeswap.Pool, tokenA common.Address, decimalsA, decimalsB uint8, withPrice bool) *quoteswap.Pool {
+	out := &quoteswap.Pool{
+		Dex:     pool.Dex,
+		Address: pool.Address.Hex(),
+		Fee:     pool.Fee,
+		Token0:  pool.Token0.Hex(),
+		Token1:  pool.Token1.Hex(),
+		Tick:    pool.Tick,
+	}
+
+	switch {
+	case pool.Reserve0 != nil:
+		out.Reserve0 = pool.Reserve0.String()
+		out.Reserve1 = pool.Reserve1.String()
+	case pool.SqrtPriceX96 != nil:
+		out.Liquidity = pool.Liquidity.String()
+		out.SqrtPriceX96 = pool.SqrtPriceX96.String()
+	}
+
+	if amountA, amountB := poolAmounts(pool, tokenA); withPrice && amountA != nil {
+		out.Price = token.FormatPrice(token.Price(amountA, decimalsA, amountB, decimalsB))
+	}
+
+	return out
+}
+
+// poolAmounts returns an amount of tokenA and the amount of the other token it is worth at the
+// pool's current price, nil when the pool has no price.
+func poolAmounts(pool pancakeswap.Pool, tokenA common.Address) (amountA, amountB *big.Int) {
+	var amount0, amount1 *big.Int
+	switch {
+	case pool.Reserve0 != nil:
+		amount0, amount1 = pool.Reserve0, pool.Reserve1
+	case pool.SqrtPriceX96 != nil:
+		amount0, amount1 = q192, new(big.Int).Mul(pool.SqrtPriceX96, pool.SqrtPriceX96)
+	default:
+		return nil, nil
+	}
+	if amount0.Sign() <= 0 || amount1.Sign() <= 0 {
+		return nil, nil
+	}
+
+	if tokenA == pool.Token0 {
+		return amount0, amount1
+	}
+
+	return amount1, amount0
+}
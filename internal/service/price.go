@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/token"
+)
+
+// priceQuote fills the pool a quote went through with its mid price, the quote's execution price
+// and its price impact. Lookups are best effort, a quote is served without them when they fail.
+func (s *QuoteSwapServiceServer) priceQuote(ctx context.Context, service pancakeswap.Swapper, req *quoteswap.GetQuoteRequest, resp *quoteswap.GetQuoteResponse) {
+	if len(resp.GetSplits()) > 0 {
+		s.priceSplit(ctx, req, resp)
+		return
+	}
+
+	quoter, ok := service.(pancakeswap.PoolQuoter)
+	if !ok || resp == nil {
+		return
+	}
+
+	pool, err := quoter.QuotePool(ctx, req, resp.GetPool().GetFee())
+	if err != nil {
+		logrus.Warnf("failed to read the %s pool of %s/%s on %s: %v", req.GetDex(), req.GetTokenIn(), req.GetTokenOut(), req.GetChain(), err)
+		return
+	}
+	if pool == nil {
+		return
+	}
+
+	tokenIn, tokenOut := common.HexToAddress(req.GetTokenIn()), common.HexToAddress(req.GetTokenOut())
+	decimalsIn, errIn := s.Tokens.Decimals(ctx, req.GetChain(), tokenIn)
+	decimalsOut, errOut := s.Tokens.Decimals(ctx, req.GetChain(), tokenOut)
+	withPrice := errIn == nil && errOut == nil
+
+	resp.Pool = poolMessage(*pool, tokenIn, decimalsIn, decimalsOut, withPrice)
+	resp.MidPrice = resp.Pool.GetPrice()
+
+	amountIn, okIn := new(big.Int).SetString(resp.GetInAmount(), 10)
+	amountOut, okOut := new(big.Int).SetString(resp.GetOutAmount(), 10)
+	if !okIn || !okOut || amountIn.Sign() <= 0 {
+		return
+	}
+
+	if withPrice {
+		resp.ExecutionPrice = token.FormatPrice(token.Price(amountIn, decimalsIn, amountOut, decimalsOut))
+	}
+
+	if midIn, midOut := poolAmounts(*pool, tokenIn); midIn != nil {
+		resp.PriceImpactBps = priceImpactBps(amountIn, amountOut, midIn, midOut)
+	}
+}
+
+// priceSplit fills the pool of every leg of a split quote, read from the leg's venue, and prices the
+// quote. Its price impact compares the output with what every leg would return at its pool's mid
+// price, so it is unknown unless all pools are read.
+func (s *QuoteSwapServiceServer) priceSplit(ctx context.Context, req *quoteswap.GetQuoteRequest, resp *quoteswap.GetQuoteResponse) {
+	tokenIn, tokenOut := common.HexToAddress(req.GetTokenIn()), common.HexToAddress(req.GetTokenOut())
+	decimalsIn, errIn := s.Tokens.Decimals(ctx, req.GetChain(), tokenIn)
+	decimalsOut, errOut := s.Tokens.Decimals(ctx, req.GetChain(), tokenOut)
+	withPrice := errIn == nil && errOut == nil
+
+	amountIn, okIn := new(big.Int).SetString(resp.GetInAmount(), 10)
+	amountOut, okOut := new(big.Int).SetString(resp.GetOutAmount(), 10)
+	if !okIn || !okOut || amountIn.Sign() <= 0 {
+		return
+	}
+	if withPrice {
+		resp.ExecutionPrice = token.FormatPrice(token.Price(amountIn, decimalsIn, amountOut, decimalsOut))
+	}
+
+	// atMid is the output of all legs at their pools' mid prices.
+	atMid := new(big.Rat)
+	for _, leg := range resp.GetSplits() {
+		legReq := &quoteswap.GetQuoteRequest{
+			TokenIn:     req.GetTokenIn(),
+			TokenOut:    req.GetTokenOut(),
+			Dex:         leg.GetPool().GetDex(),
+			Chain:       req.GetChain(),
+			BlockNumber: req.GetBlockNumber(),
+			BlockHash:   req.GetBlockHash(),
+		}
+
+		service, err := s.Venues.Swapper(ctx, req.GetChain(), legReq.GetDex())
+		quoter, ok := service.(pancakeswap.PoolQuoter)
+		if err != nil || !ok {
+			atMid = nil
+			continue
+		}
+
+		pool, err := quoter.QuotePool(ctx, legReq, leg.GetPool().GetFee())
+		if err != nil || pool == nil {
+			logrus.Warnf("failed to read the %s pool of %s/%s on %s: %v", legReq.GetDex(), req.GetTokenIn(), req.GetTokenOut(), req.GetChain(), err)
+			atMid = nil
+			continue
+		}
+		leg.Pool = poolMessage(*pool, tokenIn, decimalsIn, decimalsOut, withPrice)
+
+		legIn, ok := new(big.Int).SetString(leg.GetInAmount(), 10)
+		midIn, midOut := poolAmounts(*pool, tokenIn)
+		if atMid == nil || !ok || midIn == nil || midIn.Sign() == 0 {
+			atMid = nil
+			continue
+		}
+		atMid.Add(atMid, new(big.Rat).SetFrac(new(big.Int).Mul(legIn, midOut), midIn))
+	}
+
+	if atMid != nil && atMid.Sign() > 0 {
+		impact := new(big.Rat).Sub(big.NewRat(1, 1), new(big.Rat).Quo(new(big.Rat).SetInt(amountOut), atMid))
+		resp.PriceImpactBps = impact.Mul(impact, big.NewRat(10000, 1)).FloatString(2)
+	}
+}
+
+// priceImpactBps is how much lower amountOut/amountIn is than the mid price midOut/midIn, in basis
+// points with two decimals. Decimals cancel out, so base units are compared directly.
+func priceImpactBps(amountIn, amountOut, midIn, midOut *big.Int) string {
+	execution := new(big.Rat).SetFrac(new(big.Int).Mul(amountOut, midIn), new(big.Int).Mul(amountIn, midOut))
+	impact := new(big.Rat).Sub(big.NewRat(1, 1), execution)
+
+	return impact.Mul(impact, big.NewRat(10000, 1)).FloatString(2)
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/scheduler"
+)
+
+// maxScheduledSlices caps the number of slices of a scheduled order.
+const maxScheduledSlices = 1000
+
+// CreateScheduledOrder schedules a parent order to be sold in slices over time.
+func (s *QuoteSwapServiceServer) CreateScheduledOrder(ctx context.Context, req *quoteswap.CreateScheduledOrderRequest) (*quoteswap.ScheduledOrder, error) {
+	if s.Scheduler == nil {
+		return nil, status.Error(codes.Unimplemented, "the scheduler is not enabled")
+	}
+
+	if !common.IsHexAddress(req.GetTokenIn()) || !common.IsHexAddress(req.GetTokenOut()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token pair: %s, %s", req.GetTokenIn(), req.GetTokenOut())
+	}
+	tokenIn, tokenOut := common.HexToAddress(req.GetTokenIn()), common.HexToAddress(req.GetTokenOut())
+	if tokenIn == tokenOut {
+		return nil, status.Error(codes.InvalidArgument, "token_in and token_out must differ")
+	}
+
+	amountIn, err := pancakeswap.ParseAmount(req.GetAmountIn())
+	if err != nil || amountIn.Sign() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount in: %q", req.GetAmountIn())
+	}
+	if req.GetSlices() == 0 || req.GetSlices() > maxScheduledSlices {
+		return nil, status.Errorf(codes.InvalidArgument, "slices must be within 1-%d", maxScheduledSlices)
+	}
+	if req.GetDurationSeconds() < req.GetSlices() {
+		return nil, status.Error(codes.InvalidArgument, "duration_seconds must leave at least a second between slices")
+	}
+	if req.GetSlippageBps() > 10000 || req.GetMaxSlicePriceImpactBps() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid slippage or price impact target")
+	}
+
+	if _, err := s.Venues.Swapper(ctx, req.GetChain(), req.GetDex()); err != nil {
+		return nil, err
+	}
+
+	order, err := s.Scheduler.Create(scheduler.Order{
+		Chain:             req.GetChain(),
+		Dex:               req.GetDex(),
+		TokenIn:           tokenIn.Hex(),
+		TokenOut:          tokenOut.Hex(),
+		AmountIn:          amountIn,
+		Slices:            int(req.GetSlices()),
+		SlippageBps:       req.GetSlippageBps(),
+		MaxSliceImpactBps: req.GetMaxSlicePriceImpactBps(),
+	}, time.Duration(req.GetDurationSeconds())*time.Second)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to schedule the order: %v", err)
+	}
+
+	return scheduledOrder(order), nil
+}
+
+// CancelScheduledOrder stops an active scheduled order, a slice already sent is still settled.
+func (s *QuoteSwapServiceServer) CancelScheduledOrder(ctx context.Context, req *quoteswap.ScheduledOrderRequest) (*quoteswap.ScheduledOrder, error) {
+	if s.Scheduler == nil {
+		return nil, status.Error(codes.Unimplemented, "the scheduler is not enabled")
+	}
+
+	order, err := s.Scheduler.Cancel(req.GetId())
+	if err != nil {
+		return nil, schedulerError(req.GetId(), err)
+	}
+
+	return scheduledOrder(order), nil
+}
+
+// GetScheduledOrder returns a scheduled order with the fills of its slices.
+func (s *QuoteSwapServiceServer) GetScheduledOrder(ctx context.Context, req *quoteswap.ScheduledOrderRequest) (*quoteswap.ScheduledOrder, error) {
+	if s.Scheduler == nil {
+		return nil, status.Error(codes.Unimplemented, "the scheduler is not enabled")
+	}
+
+	order, err := s.Scheduler.Get(req.GetId())
+	if err != nil {
+		return nil, schedulerError(req.GetId(), err)
+	}
+
+	return scheduledOrder(order), nil
+}
+
+func schedulerError(id string, err error) error {
+	switch {
+	case errors.Is(err, scheduler.ErrNotFound):
+		return status.Errorf(codes.NotFound, "scheduled order %s not found", id)
+	case errors.Is(err, scheduler.ErrDone):
+		return status.Errorf(codes.FailedPrecondition, "scheduled order %s is no longer active", id)
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+var orderStatuses = map[scheduler.Status]quoteswap.OrderStatus{
+	scheduler.StatusActive:    quoteswap.OrderStatus_ORDER_ACTIVE,
+	scheduler.StatusCompleted: quoteswap.OrderStatus_ORDER_COMPLETED,
+	scheduler.StatusCancelled: quoteswap.OrderStatus_ORDER_CANCELLED,
+	scheduler.StatusExpired:   quoteswap.OrderStatus_ORDER_EXPIRED,
+	scheduler.StatusFailed:    quoteswap.OrderStatus_ORDER_FAILED,
+}
+
+var sliceStatuses = map[scheduler.SliceStatus]quoteswap.TransactionStatus{
+	scheduler.SlicePending: quoteswap.TransactionStatus_PENDING,
+	scheduler.SliceSuccess: quoteswap.TransactionStatus_SUCCESS,
+	scheduler.SliceFailed:  quoteswap.TransactionStatus_FAILED,
+	scheduler.SliceSkipped: quoteswap.TransactionStatus_UNKNOWN,
+}
+
+func scheduledOrder(order *scheduler.Order) *quoteswap.ScheduledOrder {
+	msg := &quoteswap.ScheduledOrder{
+		Id:                     order.ID,
+		Status:                 orderStatuses[order.Status],
+		Chain:                  order.Chain,
+		Dex:                    order.Dex,
+		TokenIn:                order.TokenIn,
+		TokenOut:               order.TokenOut,
+		AmountIn:               order.AmountIn.String(),
+		DurationSeconds:        uint32(order.EndsAt.Sub(order.CreatedAt) / time.Second),
+		Slices:                 uint32(order.Slices),
+		SlippageBps:            order.SlippageBps,
+		MaxSlicePriceImpactBps: order.MaxSliceImpactBps,
+		CreatedAt:              uint64(order.CreatedAt.Unix()),
+		EndsAt:                 uint64(order.EndsAt.Unix()),
+		FilledIn:               order.FilledIn.String(),
+		FilledOut:              order.FilledOut.String(),
+	}
+	if !order.Done() {
+		msg.NextSliceAt = uint64(order.NextAt.Unix())
+	}
+	if order.Error != "" {
+		msg.Error = &quoteswap.Error{Code: int32(codes.Aborted), Message: order.Error}
+	}
+
+	for _, slice := range order.Fills {
+		msg.Fills = append(msg.Fills, orderSlice(slice))
+	}
+
+	return msg
+}
+
+func orderSlice(slice scheduler.Slice) *quoteswap.OrderSlice {
+	msg := &quoteswap.OrderSlice{
+		Index:           uint32(slice.Index),
+		ExecutedAt:      uint64(slice.ExecutedAt.Unix()),
+		AmountIn:        slice.AmountIn.String(),
+		QuotedOut:       amountString(slice.QuotedOut),
+		PriceImpactBps:  slice.PriceImpactBps,
+		TransactionHash: slice.TxHash,
+		Status:          sliceStatuses[slice.Status],
+		Dex:             slice.Dex,
+	}
+	if slice.Error != "" {
+		msg.Error = &quoteswap.Error{Code: 5, Message: slice.Error}
+	}
+	if slice.Status == scheduler.SliceSuccess {
+		msg.Fill = &quoteswap.Fill{
+			AmountIn:       amountString(slice.FilledIn),
+			AmountOut:      amountString(slice.FilledOut),
+			EffectivePrice: slice.EffectivePrice,
+			GasPaid:        amountString(slice.GasPaid),
+			BlockNumber:    slice.BlockNumber,
+		}
+	}
+
+	return msg
+}
+
+func amountString(amount *big.Int) string {
+	if amount == nil {
+		return ""
+	}
+
+	return amount.String()
+}
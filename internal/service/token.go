@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/token"
+)
+
+func (s *QuoteSwapServiceServer) GetToken(ctx context.Context, req *quoteswap.GetTokenRequest) (*quoteswap.Token, error) {
+	if !common.IsHexAddress(req.GetAddress()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token address: %s", req.GetAddress())
+	}
+
+	meta, err := s.Tokens.Get(ctx, req.GetChain(), common.HexToAddress(req.GetAddress()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &quoteswap.Token{
+		Chain:              meta.Chain,
+		Address:            meta.Address.Hex(),
+		Name:               meta.Name,
+		Symbol:             meta.Symbol,
+		Decimals:           uint32(meta.Decimals),
+		TotalSupply:        meta.TotalSupply.String(),
+		TotalSupplyDecimal: token.FormatUnits(meta.TotalSupply, meta.Decimals),
+	}, nil
+}
+
+// normalizeQuoteRequest converts a human-readable amount_decimal into base units and pins the
+// request to the block it is quoted at, so swappers only deal with base-unit amounts and every
+// quote can be reproduced. The caller's request is left untouched.
+func (s *QuoteSwapServiceServer) normalizeQuoteRequest(ctx context.Context, req *quoteswap.GetQuoteRequest) (*quoteswap.GetQuoteRequest, blockchain.Block, error) {
+	block, err := s.resolveBlock(ctx, req)
+	if err != nil {
+		return nil, blockchain.Block{}, err
+	}
+
+	normalized := proto.Clone(req).(*quoteswap.GetQuoteRequest)
+	if req.GetBlockHash() == "" && req.GetBlockNumber() == 0 {
+		normalized.BlockNumber = block.Number
+	} else {
+		// A quote at an explicitly requested block is only served from that block.
+		normalized.MaxStalenessBlocks = 0
+	}
+
+	if req.GetAmountDecimal() == "" {
+		return normalized, block, nil
+	}
+
+	decimals, err := s.Tokens.Decimals(ctx, req.GetChain(), common.HexToAddress(req.GetTokenIn()))
+	if err != nil {
+		return nil, blockchain.Block{}, err
+	}
+
+	amount, err := token.ParseUnits(req.GetAmountDecimal(), decimals)
+	if err != nil {
+		return nil, blockchain.Block{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if amount.BitLen() > 256 {
+		return nil, blockchain.Block{}, status.Errorf(codes.InvalidArgument, "amount %s is out of uint256 range", req.GetAmountDecimal())
+	}
+	normalized.AmountIn = amount.String()
+
+	return normalized, block, nil
+}
+
+// decorateQuote fills the human-readable amounts of a quote. Metadata lookups are best effort,
+// a quote is still returned with base-unit amounts only when token decimals cannot be read.
+func (s *QuoteSwapServiceServer) decorateQuote(ctx context.Context, resp *quoteswap.GetQuoteResponse) {
+	if resp == nil {
+		return
+	}
+
+	resp.InAmountDecimal = s.formatAmount(ctx, resp.GetChain(), resp.GetInputToken(), resp.GetInAmount())
+	resp.OutAmountDecimal = s.formatAmount(ctx, resp.GetChain(), resp.GetOutputToken(), resp.GetOutAmount())
+}
+
+func (s *QuoteSwapServiceServer) formatAmount(ctx context.Context, chain, tokenAddress, amount string) string {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return ""
+	}
+
+	decimals, err := s.Tokens.Decimals(ctx, chain, common.HexToAddress(tokenAddress))
+	if err != nil {
+		logrus.Warnf("failed to format amount of %s on %s: %v", tokenAddress, chain, err)
+		return ""
+	}
+
+	return token.FormatUnits(value, decimals)
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/registry"
+	"grpc_cake/internal/scheduler"
+)
+
+var triggerKinds = map[quoteswap.TriggerKind]scheduler.TriggerKind{
+	quoteswap.TriggerKind_STOP_LOSS:   scheduler.StopLoss,
+	quoteswap.TriggerKind_TAKE_PROFIT: scheduler.TakeProfit,
+}
+
+// CreateTrigger stores a stop-loss or take-profit trigger, evaluated at every new head of the chain
+// until it fires, is cancelled or expires.
+func (s *QuoteSwapServiceServer) CreateTrigger(ctx context.Context, req *quoteswap.CreateTriggerRequest) (*quoteswap.Trigger, error) {
+	if s.Triggers == nil {
+		return nil, status.Error(codes.Unimplemented, "triggers are not enabled")
+	}
+
+	if !common.IsHexAddress(req.GetTokenIn()) || !common.IsHexAddress(req.GetTokenOut()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token pair: %s, %s", req.GetTokenIn(), req.GetTokenOut())
+	}
+	tokenIn, tokenOut := common.HexToAddress(req.GetTokenIn()), common.HexToAddress(req.GetTokenOut())
+	if tokenIn == tokenOut {
+		return nil, status.Error(codes.InvalidArgument, "token_in and token_out must differ")
+	}
+
+	kind, ok := triggerKinds[req.GetKind()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "kind must be STOP_LOSS or TAKE_PROFIT")
+	}
+	amountIn, err := pancakeswap.ParseAmount(req.GetAmountIn())
+	if err != nil || amountIn.Sign() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount in: %q", req.GetAmountIn())
+	}
+	if price, ok := new(big.Rat).SetString(req.GetTriggerPrice()); !ok || price.Sign() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid trigger price: %q", req.GetTriggerPrice())
+	}
+	var expiresAt time.Time
+	if req.GetExpiresAt() != 0 {
+		expiresAt = time.Unix(int64(req.GetExpiresAt()), 0)
+		if !expiresAt.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+	}
+	if req.GetSlippageBps() >= 10000 {
+		return nil, status.Error(codes.InvalidArgument, "slippage_bps must be below 10000")
+	}
+
+	if _, err := s.Venues.Swapper(ctx, req.GetChain(), req.GetDex()); err != nil {
+		return nil, err
+	}
+	if s.Clients[req.GetChain()] == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "chain %s has no client to monitor heads", req.GetChain())
+	}
+
+	// Prices are compared in whole tokens, so both decimals must resolve.
+	for _, token := range []common.Address{tokenIn, tokenOut} {
+		if _, err := s.Tokens.Decimals(ctx, req.GetChain(), token); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to resolve decimals of %s: %v", token.Hex(), err)
+		}
+	}
+
+	var twapDex string
+	if req.GetTwapWindowSeconds() > 0 {
+		if twapDex, err = s.twapDex(ctx, req.GetChain(), req.GetDex()); err != nil {
+			return nil, err
+		}
+		// The window is read once now, so a pool without enough oracle history is rejected upfront.
+		if _, err := s.twap(ctx, req.GetChain(), twapDex, tokenIn, tokenOut, 0, req.GetTwapWindowSeconds()); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to read the %ds TWAP: %v", req.GetTwapWindowSeconds(), err)
+		}
+	}
+
+	trigger, err := s.Triggers.Create(scheduler.Trigger{
+		Kind:         kind,
+		Chain:        req.GetChain(),
+		Dex:          req.GetDex(),
+		TokenIn:      tokenIn.Hex(),
+		TokenOut:     tokenOut.Hex(),
+		AmountIn:     amountIn,
+		TriggerPrice: req.GetTriggerPrice(),
+		TwapDex:      twapDex,
+		TwapWindow:   req.GetTwapWindowSeconds(),
+		SlippageBps:  req.GetSlippageBps(),
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create the trigger: %v", err)
+	}
+
+	return triggerMessage(trigger), nil
+}
+
+// CancelTrigger stops evaluating an active trigger, a swap already sent is still settled.
+func (s *QuoteSwapServiceServer) CancelTrigger(ctx context.Context, req *quoteswap.TriggerRequest) (*quoteswap.Trigger, error) {
+	if s.Triggers == nil {
+		return nil, status.Error(codes.Unimplemented, "triggers are not enabled")
+	}
+
+	trigger, err := s.Triggers.Cancel(req.GetId())
+	if err != nil {
+		return nil, triggerError(req.GetId(), err)
+	}
+
+	return triggerMessage(trigger), nil
+}
+
+// GetTrigger returns a trigger with its latest evaluations and the swaps sent for it.
+func (s *QuoteSwapServiceServer) GetTrigger(ctx context.Context, req *quoteswap.TriggerRequest) (*quoteswap.Trigger, error) {
+	if s.Triggers == nil {
+		return nil, status.Error(codes.Unimplemented, "triggers are not enabled")
+	}
+
+	trigger, err := s.Triggers.Get(req.GetId())
+	if err != nil {
+		return nil, triggerError(req.GetId(), err)
+	}
+
+	return triggerMessage(trigger), nil
+}
+
+// ListTriggerEvaluations streams every evaluation of a trigger from the audit file, oldest first.
+func (s *QuoteSwapServiceServer) ListTriggerEvaluations(req *quoteswap.TriggerRequest, stream grpc.ServerStreamingServer[quoteswap.TriggerEvaluation]) error {
+	if s.Triggers == nil {
+		return status.Error(codes.Unimplemented, "triggers are not enabled")
+	}
+
+	err := s.Triggers.Evaluations(req.GetId(), func(evaluation scheduler.Evaluation) error {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		return stream.Send(evaluationMessage(evaluation))
+	})
+	if err != nil {
+		return triggerError(req.GetId(), err)
+	}
+
+	return nil
+}
+
+// twapDex returns the venue whose pool oracle smooths a trigger on dex: dex itself, or the V3 then
+// the V2 venue of the chain for the smart router, which has no oracle of its own.
+func (s *QuoteSwapServiceServer) twapDex(ctx context.Context, chain, dex string) (string, error) {
+	candidates := []string{dex}
+	if dex == registry.DexSmart {
+		candidates = []string{registry.DexV3, registry.DexV2}
+	}
+
+	for _, candidate := range candidates {
+		service, err := s.Venues.Swapper(ctx, chain, candidate)
+		if err != nil {
+			continue
+		}
+		if _, ok := service.(pancakeswap.TwapReader); ok {
+			return candidate, nil
+		}
+	}
+
+	return "", status.Errorf(codes.FailedPrecondition, "%s on %s has no price oracle to smooth the trigger", dex, chain)
+}
+
+func triggerError(id string, err error) error {
+	switch {
+	case errors.Is(err, scheduler.ErrTriggerNotFound):
+		return status.Errorf(codes.NotFound, "trigger %s not found", id)
+	case errors.Is(err, scheduler.ErrTriggerDone):
+		return status.Errorf(codes.FailedPrecondition, "trigger %s is no longer active", id)
+	case status.Code(err) != codes.Unknown:
+		return err
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func triggerMessage(trigger *scheduler.Trigger) *quoteswap.Trigger {
+	msg := &quoteswap.Trigger{
+		Id:                trigger.ID,
+		Status:            orderStatuses[trigger.Status],
+		Chain:             trigger.Chain,
+		Dex:               trigger.Dex,
+		TokenIn:           trigger.TokenIn,
+		TokenOut:          trigger.TokenOut,
+		AmountIn:          trigger.AmountIn.String(),
+		TriggerPrice:      trigger.TriggerPrice,
+		TwapWindowSeconds: trigger.TwapWindow,
+		SlippageBps:       trigger.SlippageBps,
+		CreatedAt:         uint64(trigger.CreatedAt.Unix()),
+		Evaluations:       uint64(trigger.Evaluations),
+	}
+	for kind, name := range triggerKinds {
+		if name == trigger.Kind {
+			msg.Kind = kind
+		}
+	}
+	if !trigger.ExpiresAt.IsZero() {
+		msg.ExpiresAt = uint64(trigger.ExpiresAt.Unix())
+	}
+	if trigger.Error != "" {
+		msg.Error = &quoteswap.Error{Code: int32(codes.Aborted), Message: trigger.Error}
+	}
+
+	for _, evaluation := range trigger.Recent {
+		msg.RecentEvaluations = append(msg.RecentEvaluations, evaluationMessage(evaluation))
+	}
+	for _, execution := range trigger.Executions {
+		msg.Executions = append(msg.Executions, orderSlice(execution))
+	}
+
+	return msg
+}
+
+func evaluationMessage(evaluation scheduler.Evaluation) *quoteswap.TriggerEvaluation {
+	msg := &quoteswap.TriggerEvaluation{
+		TriggerId:      evaluation.TriggerID,
+		BlockNumber:    evaluation.BlockNumber,
+		BlockTimestamp: evaluation.BlockTime,
+		EvaluatedAt:    uint64(evaluation.EvaluatedAt.Unix()),
+		QuotedOut:      amountString(evaluation.QuotedOut),
+		Price:          evaluation.Price,
+		TwapPrice:      evaluation.TwapPrice,
+		Met:            evaluation.Met,
+	}
+	if evaluation.Error != "" {
+		msg.Error = &quoteswap.Error{Code: int32(codes.Unavailable), Message: evaluation.Error}
+	}
+
+	return msg
+}
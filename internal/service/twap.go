@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/token"
+)
+
+// defaultTwapWindow is the TWAP window in seconds when a request or the guardrails give none.
+const defaultTwapWindow = 1800
+
+// GetTwap returns the time-weighted average price of a pool over a window ending at the chain head.
+func (s *QuoteSwapServiceServer) GetTwap(ctx context.Context, req *quoteswap.GetTwapRequest) (*quoteswap.GetTwapResponse, error) {
+	if !common.IsHexAddress(req.GetTokenA()) || !common.IsHexAddress(req.GetTokenB()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token pair: %s, %s", req.GetTokenA(), req.GetTokenB())
+	}
+	tokenA, tokenB := common.HexToAddress(req.GetTokenA()), common.HexToAddress(req.GetTokenB())
+	if tokenA == tokenB {
+		return nil, status.Error(codes.InvalidArgument, "token_a and token_b must differ")
+	}
+
+	twap, err := s.twap(ctx, req.GetChain(), req.GetDex(), tokenA, tokenB, req.GetFee(), req.GetWindowSeconds())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &quoteswap.GetTwapResponse{
+		Pool:           poolMessage(twap.Pool, tokenA, 0, 0, false),
+		BlockNumber:    twap.To.Number,
+		BlockTimestamp: twap.To.Time,
+		WindowSeconds:  uint32(twap.Window),
+		Tick:           twap.Tick,
+	}
+	if twap.HarmonicMeanLiquidity != nil {
+		resp.HarmonicMeanLiquidity = twap.HarmonicMeanLiquidity.String()
+	}
+
+	decimalsA, errA := s.Tokens.Decimals(ctx, req.GetChain(), tokenA)
+	decimalsB, errB := s.Tokens.Decimals(ctx, req.GetChain(), tokenB)
+	if errA == nil && errB == nil {
+		amountA, amountB := twapAmounts(twap, tokenA)
+		resp.Price = token.FormatPrice(token.Price(amountA, decimalsA, amountB, decimalsB))
+	}
+
+	return resp, nil
+}
+
+// twap reads the average price of the pool of tokenA and tokenB on a venue, over window seconds
+// or defaultTwapWindow.
+func (s *QuoteSwapServiceServer) twap(ctx context.Context, chain, dex string, tokenA, tokenB common.Address, fee, window uint32) (*pancakeswap.Twap, error) {
+	service, err := s.Venues.Swapper(ctx, chain, dex)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, ok := service.(pancakeswap.TwapReader)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%s on %s has no price oracle", dex, chain)
+	}
+
+	if window == 0 {
+		window = defaultTwapWindow
+	}
+
+	return reader.Twap(ctx, tokenA, tokenB, fee, uint64(window))
+}
+
+// twapAmounts returns an amount of tokenA and the amount of the other token it was worth on average.
+func twapAmounts(twap *pancakeswap.Twap, tokenA common.Address) (amountA, amountB *big.Int) {
+	if tokenA == twap.Pool.Token0 {
+		return twap.Amount0, twap.Amount1
+	}
+
+	return twap.Amount1, twap.Amount0
+}
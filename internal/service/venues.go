@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpc_cake/gen/go/quoteswap"
+	"grpc_cake/internal/blockchain"
+	"grpc_cake/internal/pancakeswap"
+)
+
+// Venues is the set of (chain, dex) pairs the service is configured for. A venue that failed to
+// start or whose contracts do not match the registry stays listed but is never served.
+type Venues struct {
+	mu     sync.RWMutex
+	venues map[venueKey]*venue
+}
+
+type venueKey struct {
+	chain string
+	dex   string
+}
+
+type venue struct {
+	swapper pancakeswap.Swapper
+	client  *blockchain.Client
+	// err is set once the venue is known to be unusable until a restart.
+	err error
+}
+
+func NewVenues() *Venues {
+	return &Venues{venues: make(map[venueKey]*venue)}
+}
+
+// Add registers a venue served by swapper over client.
+func (v *Venues) Add(chain, dex string, swapper pancakeswap.Swapper, client *blockchain.Client) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.venues[venueKey{chain: chain, dex: dex}] = &venue{swapper: swapper, client: client}
+}
+
+// Disable registers a venue that cannot be served, err is reported to its callers.
+func (v *Venues) Disable(chain, dex string, err error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	key := venueKey{chain: chain, dex: dex}
+	if existing, ok := v.venues[key]; ok {
+		existing.err = err
+		return
+	}
+	v.venues[key] = &venue{err: err}
+}
+
+// Swapper returns the swapper of a ready venue. Unknown venues fail with NOT_FOUND, venues that
+// are disabled, have no reachable RPC endpoint or fail verification fail with UNAVAILABLE.
+func (v *Venues) Swapper(ctx context.Context, chain, dex string) (pancakeswap.Swapper, error) {
+	v.mu.RLock()
+	ven, ok := v.venues[venueKey{chain: chain, dex: dex}]
+	var err error
+	if ok {
+		err = ven.ready()
+	}
+	v.mu.RUnlock()
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no %s venue found for chain: %s", dex, chain)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%s on %s is unavailable: %v", dex, chain, err)
+	}
+
+	if err := v.verify(ctx, chain, dex, ven); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%s on %s is unavailable: %v", dex, chain, err)
+	}
+
+	return ven.swapper, nil
+}
+
+// Verify checks the contracts of every venue against the registry and disables the misconfigured
+// ones. Venues that cannot be reached are verified again on their first use.
+func (v *Venues) Verify(ctx context.Context) {
+	v.mu.RLock()
+	venues := make(map[venueKey]*venue, len(v.venues))
+	for key, ven := range v.venues {
+		if ven.ready() == nil {
+			venues[key] = ven
+		}
+	}
+	v.mu.RUnlock()
+
+	for key, ven := range venues {
+		if err := v.verify(ctx, key.chain, key.dex, ven); err != nil && !errors.Is(err, blockchain.ErrMisconfigured) {
+			logrus.Warnf("could not verify %s on %s at startup, verifying on first use: %v", key.dex, key.chain, err)
+		}
+	}
+}
+
+// List returns every venue, of chain only when it is set, sorted by chain and dex.
+func (v *Venues) List(chain string) []*quoteswap.Venue {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	var venues []*quoteswap.Venue
+	for key, ven := range v.venues {
+		if chain != "" && key.chain != chain {
+			continue
+		}
+
+		venue := &quoteswap.Venue{Chain: key.chain, Dex: key.dex, Ready: true}
+		if err := ven.ready(); err != nil {
+			venue.Ready = false
+			venue.Error = &quoteswap.Error{Code: int32(codes.Unavailable), Message: err.Error()}
+		}
+		venues = append(venues, venue)
+	}
+
+	sort.Slice(venues, func(i, j int) bool {
+		if venues[i].Chain != venues[j].Chain {
+			return venues[i].Chain < venues[j].Chain
+		}
+		return venues[i].Dex < venues[j].Dex
+	})
+
+	return venues
+}
+
+// ready reports why the venue cannot be served right now, or nil. The Venues lock must be held.
+func (ven *venue) ready() error {
+	switch {
+	case ven.err != nil:
+		return ven.err
+	case ven.client != nil && !ven.client.Ready():
+		return blockchain.ErrNoEndpoint
+	default:
+		return nil
+	}
+}
+
+// verify runs the swapper's wiring check, if it has one, and disables the venue when it is misconfigured.
+func (v *Venues) verify(ctx context.Context, chain, dex string, ven *venue) error {
+	verifier, ok := ven.swapper.(pancakeswap.Verifier)
+	if !ok {
+		return nil
+	}
+
+	err := verifier.Verify(ctx)
+	if errors.Is(err, blockchain.ErrMisconfigured) {
+		logrus.Errorf("disabling %s on %s: %v", dex, chain, err)
+		v.Disable(chain, dex, err)
+	}
+
+	return err
+}
+
+func (s *QuoteSwapServiceServer) ListVenues(ctx context.Context, req *quoteswap.ListVenuesRequest) (*quoteswap.ListVenuesResponse, error) {
+	return &quoteswap.ListVenuesResponse{Venues: s.Venues.List(req.GetChain())}, nil
+}
@@ -0,0 +1,118 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+	"grpc_cake/internal/blockchain"
+	erc20 "grpc_cake/internal/blockchain/abi/gen/erc20"
+)
+
+// supplyTTL is how long a cached total supply is served before it is read again.
+// Name, symbol and decimals never change and are cached for the process lifetime.
+const supplyTTL = 5 * time.Minute
+
+type Metadata struct {
+	Chain       string
+	Address     common.Address
+	Name        string
+	Symbol      string
+	Decimals    uint8
+	TotalSupply *big.Int
+
+	supplyAt time.Time
+}
+
+// Registry looks up ERC-20 metadata through the chain clients and caches it per chain and address.
+type Registry struct {
+	clients map[string]*blockchain.Client
+
+	mu    sync.RWMutex
+	cache map[string]map[common.Address]*Metadata
+}
+
+func NewRegistry(clients map[string]*blockchain.Client) *Registry {
+	return &Registry{
+		clients: clients,
+		cache:   make(map[string]map[common.Address]*Metadata),
+	}
+}
+
+// Get returns the metadata of the token at address on chain, reading it from the chain on first use.
+func (r *Registry) Get(ctx context.Context, chain string, address common.Address) (*Metadata, error) {
+	r.mu.RLock()
+	cached := r.cache[chain][address]
+	r.mu.RUnlock()
+
+	if cached != nil && time.Since(cached.supplyAt) < supplyTTL {
+		return cached, nil
+	}
+
+	client, ok := r.clients[chain]
+	if !ok {
+		return nil, fmt.Errorf("no client found for chain: %s", chain)
+	}
+
+	token, err := erc20.NewBlockchainCaller(address, client.Eth())
+	if err != nil {
+		return nil, err
+	}
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	meta := &Metadata{Chain: chain, Address: address}
+	if cached != nil {
+		meta.Name, meta.Symbol, meta.Decimals = cached.Name, cached.Symbol, cached.Decimals
+	} else {
+		meta.Decimals, err = token.Decimals(callOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read decimals of %s on %s: %w", address.Hex(), chain, err)
+		}
+
+		// Some older tokens return bytes32 instead of string, they are still usable without a name.
+		if meta.Name, err = token.Name(callOpts); err != nil {
+			logrus.Warnf("failed to read name of %s on %s: %v", address.Hex(), chain, err)
+		}
+		if meta.Symbol, err = token.Symbol(callOpts); err != nil {
+			logrus.Warnf("failed to read symbol of %s on %s: %v", address.Hex(), chain, err)
+		}
+	}
+
+	meta.TotalSupply, err = token.TotalSupply(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read total supply of %s on %s: %w", address.Hex(), chain, err)
+	}
+	meta.supplyAt = time.Now()
+
+	r.mu.Lock()
+	if r.cache[chain] == nil {
+		r.cache[chain] = make(map[common.Address]*Metadata)
+	}
+	r.cache[chain][address] = meta
+	r.mu.Unlock()
+
+	return meta, nil
+}
+
+// Decimals returns the decimals of the token, served from cache once known.
+func (r *Registry) Decimals(ctx context.Context, chain string, address common.Address) (uint8, error) {
+	r.mu.RLock()
+	cached := r.cache[chain][address]
+	r.mu.RUnlock()
+
+	if cached != nil {
+		return cached.Decimals, nil
+	}
+
+	meta, err := r.Get(ctx, chain, address)
+	if err != nil {
+		return 0, err
+	}
+
+	return meta.Decimals, nil
+}
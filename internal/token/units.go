@@ -0,0 +1,88 @@
+package token
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ParseUnits converts a human-readable decimal amount such as "1.5" into base units of a
+// token with the given decimals. Amounts with more fractional digits than decimals are rejected.
+func ParseUnits(amount string, decimals uint8) (*big.Int, error) {
+	amount = strings.TrimSpace(amount)
+	if amount == "" {
+		return nil, fmt.Errorf("empty amount")
+	}
+
+	whole, frac, _ := strings.Cut(amount, ".")
+	if len(frac) > int(decimals) {
+		return nil, fmt.Errorf("amount %s has more than %d decimals", amount, decimals)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+
+	digits := whole + frac + strings.Repeat("0", int(decimals)-len(frac))
+
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok || value.Sign() < 0 || strings.ContainsAny(digits, "+-") {
+		return nil, fmt.Errorf("invalid amount: %s", amount)
+	}
+
+	return value, nil
+}
+
+// FormatUnits renders a base-unit amount as a decimal string, trimming trailing zeros.
+func FormatUnits(amount *big.Int, decimals uint8) string {
+	if amount == nil {
+		return "0"
+	}
+
+	negative := amount.Sign() < 0
+	digits := new(big.Int).Abs(amount).String()
+
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+
+	whole := digits[:len(digits)-int(decimals)]
+	frac := strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+
+	result := whole
+	if frac != "" {
+		result += "." + frac
+	}
+	if negative {
+		result = "-" + result
+	}
+
+	return result
+}
+
+// Price returns how many tokenOut one tokenIn is worth, given base-unit amounts and decimals of both tokens.
+func Price(amountIn *big.Int, decimalsIn uint8, amountOut *big.Int, decimalsOut uint8) *big.Float {
+	if amountIn == nil || amountIn.Sign() == 0 || amountOut == nil {
+		return new(big.Float)
+	}
+
+	in := new(big.Float).SetInt(amountIn)
+	in.Quo(in, pow10(decimalsIn))
+
+	out := new(big.Float).SetInt(amountOut)
+	out.Quo(out, pow10(decimalsOut))
+
+	return out.Quo(out, in)
+}
+
+// FormatPrice renders a price with enough significant digits for any realistic token pair.
+func FormatPrice(price *big.Float) string {
+	if price == nil {
+		return "0"
+	}
+
+	return price.Text('g', 18)
+}
+
+func pow10(decimals uint8) *big.Float {
+	return new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+}
@@ -1,55 +1,90 @@
 package main
 
 import (
-	"log"
+	"context"
+	"expvar"
+	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"grpc_cake/gen/go/quoteswap"
 	"grpc_cake/internal/blockchain"
 	"grpc_cake/internal/pancakeswap"
+	"grpc_cake/internal/pancakeswap/smart"
 	v2 "grpc_cake/internal/pancakeswap/v2"
 	v3 "grpc_cake/internal/pancakeswap/v3"
+	"grpc_cake/internal/registry"
+	"grpc_cake/internal/scheduler"
 	"grpc_cake/internal/service"
+	"grpc_cake/internal/token"
 )
 
-func main() {
-	s := grpc.NewServer()
+// verifyTimeout bounds the startup verification of all venues.
+const verifyTimeout = 30 * time.Second
 
-	v2Services := make(map[string]pancakeswap.Swapper)
-	v3Services := make(map[string]pancakeswap.Swapper)
+func main() {
+	godotenv.Load()
 
-	clients := make(map[string]*blockchain.Client)
+	reg, err := registry.Load()
+	if err != nil {
+		logrus.Fatalf("failed to load registry: %v", err)
+	}
 
-	for _, chain := range []string{blockchain.ChainBSC, blockchain.ChainETH, blockchain.ChainBase} {
-		client, err := blockchain.NewClient(chain)
-		if err != nil {
-			logrus.Errorf("failed to create client for chain %s: %v", chain, err)
-			continue
+	if len(os.Args) > 1 && os.Args[1] == "history" {
+		if err := runHistory(reg, os.Args[2:]); err != nil {
+			logrus.Fatalf("history: %v", err)
 		}
-		clients[chain] = client
+		return
+	}
 
-		v2Service, err := v2.NewV2(client)
-		if err != nil {
-			log.Fatalf("failed to create V2 service for %s: %v", chain, err)
-		}
-		v2Services[chain] = v2Service
+	s := grpc.NewServer()
 
-		v3Service, err := v3.NewV3(client)
-		if err != nil {
-			logrus.Errorf("failed to create V3 service for %s: %v", chain, err)
-		}
-		v3Services[chain] = v3Service
+	srv := newServer(reg)
+
+	statePath := os.Getenv("SCHEDULER_STATE_PATH")
+	if statePath == "" {
+		statePath = scheduler.DefaultPath
+	}
+	srv.Scheduler, err = scheduler.New(statePath, srv)
+	if err != nil {
+		logrus.Fatalf("failed to load the scheduler: %v", err)
+	}
+
+	limitsPath := os.Getenv("LIMIT_ORDERS_PATH")
+	if limitsPath == "" {
+		limitsPath = scheduler.DefaultLimitPath
+	}
+	srv.Limits, err = scheduler.NewLimitBook(limitsPath, srv)
+	if err != nil {
+		logrus.Fatalf("failed to load limit orders: %v", err)
 	}
 
-	srv := &service.QuoteSwapServiceServer{
-		V2Services: v2Services,
-		V3Services: v3Services,
+	triggersPath := os.Getenv("TRIGGERS_PATH")
+	if triggersPath == "" {
+		triggersPath = scheduler.DefaultTriggerPath
+	}
+	srv.Triggers, err = scheduler.NewTriggerBook(triggersPath, scheduler.AuditPath(triggersPath), srv)
+	if err != nil {
+		logrus.Fatalf("failed to load triggers: %v", err)
+	}
+
+	plansPath := os.Getenv("DCA_PLANS_PATH")
+	if plansPath == "" {
+		plansPath = scheduler.DefaultPlanPath
+	}
+	srv.Planner, err = scheduler.NewPlanner(plansPath, srv)
+	if err != nil {
+		logrus.Fatalf("failed to load DCA plans: %v", err)
 	}
 
 	quoteswap.RegisterQuoteSwapServiceServer(s, srv)
@@ -66,6 +101,17 @@ func main() {
 		logrus.Fatalf("failed to listen: %v", err)
 	}
 
+	if addr := os.Getenv("METRICS_ADDR"); addr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/vars", expvar.Handler())
+
+		go func() {
+			if err := http.ListenAndServe(addr, mux); err != nil {
+				logrus.Errorf("failed to serve metrics: %v", err)
+			}
+		}()
+	}
+
 	logrus.Info("Starting QuoteSwap service...")
 
 	go func() {
@@ -74,11 +120,121 @@ func main() {
 		}
 	}()
 
+	srv.Scheduler.Start()
+	srv.Limits.Start(srv.Clients)
+	srv.Triggers.Start(srv.Clients)
+	srv.Planner.Start()
+
 	stopCh := make(chan os.Signal, 1)
 	signal.Notify(stopCh, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 	<-stopCh
 
 	logrus.Info("Shutting down QuoteSwap service...")
 	s.GracefulStop()
+	srv.Scheduler.Stop()
+	srv.Limits.Stop()
+	srv.Triggers.Stop()
+	srv.Planner.Stop()
+	for _, client := range srv.Clients {
+		client.Close()
+	}
 	logrus.Info("Service has been stopped gracefully.")
 }
+
+// newServer connects to every chain of the registry and creates the service for its venues.
+func newServer(reg *registry.Registry) *service.QuoteSwapServiceServer {
+	venues := service.NewVenues()
+	clients := make(map[string]*blockchain.Client)
+
+	for _, chain := range reg.Chains {
+		client, err := blockchain.NewClient(chain)
+		if err != nil {
+			logrus.Infof("skipping chain %s: %v", chain.Name, err)
+			for _, dex := range chain.Dexes {
+				venues.Disable(chain.Name, dex.Name, err)
+			}
+			continue
+		}
+		clients[chain.Name] = client
+
+		// The smart router splits orders across the pools of the chain's other venues, so it is
+		// created once they are.
+		var quoters []pancakeswap.CurveQuoter
+		for _, dex := range chain.Dexes {
+			if dex.Name == registry.DexSmart {
+				continue
+			}
+
+			swapper, err := newSwapper(client, dex)
+			if err != nil {
+				logrus.Errorf("failed to create %s service for %s: %v", dex.Name, chain.Name, err)
+				venues.Disable(chain.Name, dex.Name, err)
+				continue
+			}
+			venues.Add(chain.Name, dex.Name, swapper, client)
+
+			if quoter, ok := swapper.(pancakeswap.CurveQuoter); ok {
+				quoters = append(quoters, quoter)
+			}
+		}
+
+		if dex, ok := chain.Dex(registry.DexSmart); ok {
+			swapper, err := smart.NewSmart(client, dex, quoters)
+			if err != nil {
+				logrus.Errorf("failed to create %s service for %s: %v", dex.Name, chain.Name, err)
+				venues.Disable(chain.Name, dex.Name, err)
+				continue
+			}
+			venues.Add(chain.Name, dex.Name, swapper, client)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), verifyTimeout)
+	venues.Verify(ctx)
+	cancel()
+
+	return &service.QuoteSwapServiceServer{
+		Venues:  venues,
+		Clients: clients,
+		Tokens:  token.NewRegistry(clients),
+		Quotes:  service.NewQuoteCache(clients),
+		Wallets: wallets(),
+	}
+}
+
+// newSwapper creates the Swapper implementation of a registry DEX.
+func newSwapper(client *blockchain.Client, dex registry.Dex) (pancakeswap.Swapper, error) {
+	switch dex.Name {
+	case registry.DexV2:
+		return v2.NewV2(client, dex)
+	case registry.DexV3:
+		return v3.NewV3(client, dex)
+	default:
+		return nil, fmt.Errorf("unsupported dex version: %s", dex.Name)
+	}
+}
+
+// wallets returns the addresses from WALLET_ADDRS (comma separated) and RECIPIENT_ADDR, without duplicates.
+func wallets() []common.Address {
+	var result []common.Address
+	seen := make(map[common.Address]bool)
+
+	for _, addr := range append(strings.Split(os.Getenv("WALLET_ADDRS"), ","), os.Getenv("RECIPIENT_ADDR")) {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+		if !common.IsHexAddress(addr) {
+			logrus.Warnf("ignoring invalid wallet address: %s", addr)
+			continue
+		}
+
+		wallet := common.HexToAddress(addr)
+		if !seen[wallet] {
+			seen[wallet] = true
+			result = append(result, wallet)
+		}
+	}
+
+	return result
+}